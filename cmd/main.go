@@ -4,10 +4,21 @@ import (
 	"context"
 	"crud/internal/app"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
 )
 
 func main() {
-	err := app.Start(context.Background())
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		// после первого сигнала обработка возвращается по умолчанию, повторный завершает процесс сразу
+		<-ctx.Done()
+		stop()
+	}()
+
+	err := app.Run(ctx, os.Args[1:])
+	stop()
 	if err != nil {
 		log.Fatal(err.Error())
 	}
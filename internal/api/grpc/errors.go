@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+	"crud/internal/domain"
+	"errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type statusKind struct {
+	kind error
+	code codes.Code
+}
+
+// statusKinds сопоставляет виды ошибок предметной области с кодами gRPC
+var statusKinds = []statusKind{
+	{domain.ErrValidation, codes.InvalidArgument},
+	{domain.ErrNotFound, codes.NotFound},
+	{domain.ErrConflict, codes.AlreadyExists},
+	{domain.ErrPreconditionFailed, codes.FailedPrecondition},
+	{domain.ErrGone, codes.OutOfRange},
+	{domain.ErrUnavailable, codes.Unavailable},
+	{domain.ErrCanceled, codes.Canceled},
+}
+
+// UnaryErrorInterceptor превращает ошибку обработчика в статус gRPC.
+// Ошибки, не относящиеся к предметной области, отдаются как Internal без подробностей
+func (s Server) UnaryErrorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, s.toStatus(info.FullMethod, err)
+	}
+
+	return resp, nil
+}
+
+// StreamErrorInterceptor то же, что UnaryErrorInterceptor, для потоковых вызовов
+func (s Server) StreamErrorInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := handler(srv, ss); err != nil {
+		return s.toStatus(info.FullMethod, err)
+	}
+
+	return nil
+}
+
+func (s Server) toStatus(method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		s.Logger.Error(err.Error(), "method", method)
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	code := statusCodeOf(domainErr)
+	if code == codes.Unavailable || code == codes.Internal {
+		s.Logger.Error(err.Error(), "method", method)
+	}
+
+	st := status.New(code, domainErr.Detail)
+	if len(domainErr.Fields) == 0 {
+		return st.Err()
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(domainErr.Fields))
+	for _, f := range domainErr.Fields {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: f.Field, Description: f.Reason})
+	}
+	if withDetails, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+		st = withDetails
+	}
+
+	return st.Err()
+}
+
+func statusCodeOf(err *domain.Error) codes.Code {
+	for _, k := range statusKinds {
+		if errors.Is(err, k.kind) {
+			return k.code
+		}
+	}
+
+	return codes.Internal
+}
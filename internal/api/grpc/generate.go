@@ -0,0 +1,3 @@
+package grpc
+
+//go:generate buf generate
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// healthWatchInterval период опроса Checker для подписчиков Watch
+const healthWatchInterval = 5 * time.Second
+
+type healthChecker interface {
+	HealthCheck(ctx context.Context) bool
+}
+
+// HealthServer реализует протокол grpc.health.v1 поверх готовности Checker, как /ready в HTTP API.
+// Поддерживаются общее состояние сервера (пустое имя сервиса) и ItemService
+type HealthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+
+	Checker healthChecker
+}
+
+func (h HealthServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	if !knownService(req.GetService()) {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+
+	return &grpc_health_v1.HealthCheckResponse{Status: h.status(ctx)}, nil
+}
+
+// Watch отправляет текущее состояние и далее только его изменения, пока клиент не отменит поток
+func (h HealthServer) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
+	ctx := stream.Context()
+	if !knownService(req.GetService()) {
+		return stream.Send(&grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN})
+	}
+
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+
+	last := grpc_health_v1.HealthCheckResponse_UNKNOWN
+	for {
+		if current := h.status(ctx); current != last {
+			if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: current}); err != nil {
+				return err
+			}
+			last = current
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h HealthServer) status(ctx context.Context) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if !h.Checker.HealthCheck(ctx) {
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+
+	return grpc_health_v1.HealthCheckResponse_SERVING
+}
+
+func knownService(name string) bool {
+	return name == "" || name == ItemService_ServiceDesc.ServiceName
+}
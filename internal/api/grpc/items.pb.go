@@ -0,0 +1,984 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: items.proto
+
+package grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ItemEvent_Type int32
+
+const (
+	ItemEvent_TYPE_UNSPECIFIED ItemEvent_Type = 0
+	ItemEvent_TYPE_CREATED     ItemEvent_Type = 1
+	ItemEvent_TYPE_UPDATED     ItemEvent_Type = 2
+	ItemEvent_TYPE_DELETED     ItemEvent_Type = 3
+)
+
+// Enum value maps for ItemEvent_Type.
+var (
+	ItemEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_CREATED",
+		2: "TYPE_UPDATED",
+		3: "TYPE_DELETED",
+	}
+	ItemEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_CREATED":     1,
+		"TYPE_UPDATED":     2,
+		"TYPE_DELETED":     3,
+	}
+)
+
+func (x ItemEvent_Type) Enum() *ItemEvent_Type {
+	p := new(ItemEvent_Type)
+	*p = x
+	return p
+}
+
+func (x ItemEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ItemEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_items_proto_enumTypes[0].Descriptor()
+}
+
+func (ItemEvent_Type) Type() protoreflect.EnumType {
+	return &file_items_proto_enumTypes[0]
+}
+
+func (x ItemEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ItemEvent_Type.Descriptor instead.
+func (ItemEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_items_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type Atom struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Atom) Reset() {
+	*x = Atom{}
+	mi := &file_items_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Atom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Atom) ProtoMessage() {}
+
+func (x *Atom) ProtoReflect() protoreflect.Message {
+	mi := &file_items_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Atom.ProtoReflect.Descriptor instead.
+func (*Atom) Descriptor() ([]byte, []int) {
+	return file_items_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Atom) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Atom) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Nested struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Sort          int64                  `protobuf:"varint,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Related       []*Atom                `protobuf:"bytes,4,rep,name=related,proto3" json:"related,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Nested) Reset() {
+	*x = Nested{}
+	mi := &file_items_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Nested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nested) ProtoMessage() {}
+
+func (x *Nested) ProtoReflect() protoreflect.Message {
+	mi := &file_items_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nested.ProtoReflect.Descriptor instead.
+func (*Nested) Descriptor() ([]byte, []int) {
+	return file_items_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Nested) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Nested) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Nested) GetSort() int64 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *Nested) GetRelated() []*Atom {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+type Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Sort          int64                  `protobuf:"varint,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Related       []*Nested              `protobuf:"bytes,4,rep,name=related,proto3" json:"related,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_items_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_items_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_items_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Item) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Item) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Item) GetSort() int64 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *Item) GetRelated() []*Nested {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+func (x *Item) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Item) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Related       []*Nested              `protobuf:"bytes,2,rep,name=related,proto3" json:"related,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateItemRequest) Reset() {
+	*x = CreateItemRequest{}
+	mi := &file_items_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateItemRequest) ProtoMessage() {}
+
+func (x *CreateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_items_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateItemRequest) Descriptor() ([]byte, []int) {
+	return file_items_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetRelated() []*Nested {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+type CreateItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateItemResponse) Reset() {
+	*x = CreateItemResponse{}
+	mi := &file_items_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateItemResponse) ProtoMessage() {}
+
+func (x *CreateItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_items_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateItemResponse.ProtoReflect.Descriptor instead.
+func (*CreateItemResponse) Descriptor() ([]byte, []int) {
+	return file_items_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateItemResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemRequest) Reset() {
+	*x = GetItemRequest{}
+	mi := &file_items_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemRequest) ProtoMessage() {}
+
+func (x *GetItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_items_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemRequest.ProtoReflect.Descriptor instead.
+func (*GetItemRequest) Descriptor() ([]byte, []int) {
+	return file_items_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Related       []*Nested              `protobuf:"bytes,3,rep,name=related,proto3" json:"related,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateItemRequest) Reset() {
+	*x = UpdateItemRequest{}
+	mi := &file_items_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemRequest) ProtoMessage() {}
+
+func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_items_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateItemRequest) Descriptor() ([]byte, []int) {
+	return file_items_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetRelated() []*Nested {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+type DeleteItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteItemRequest) Reset() {
+	*x = DeleteItemRequest{}
+	mi := &file_items_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteItemRequest) ProtoMessage() {}
+
+func (x *DeleteItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_items_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteItemRequest) Descriptor() ([]byte, []int) {
+	return file_items_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListItemsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Limit  int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// related_id и atom_id ограничивают выборку документами, содержащими вложенный документ или атом с этим ID
+	RelatedId     string `protobuf:"bytes,3,opt,name=related_id,json=relatedId,proto3" json:"related_id,omitempty"`
+	AtomId        string `protobuf:"bytes,4,opt,name=atom_id,json=atomId,proto3" json:"atom_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemsRequest) Reset() {
+	*x = ListItemsRequest{}
+	mi := &file_items_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsRequest) ProtoMessage() {}
+
+func (x *ListItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_items_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListItemsRequest) Descriptor() ([]byte, []int) {
+	return file_items_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListItemsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListItemsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListItemsRequest) GetRelatedId() string {
+	if x != nil {
+		return x.RelatedId
+	}
+	return ""
+}
+
+func (x *ListItemsRequest) GetAtomId() string {
+	if x != nil {
+		return x.AtomId
+	}
+	return ""
+}
+
+type ListItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemsResponse) Reset() {
+	*x = ListItemsResponse{}
+	mi := &file_items_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsResponse) ProtoMessage() {}
+
+func (x *ListItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_items_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListItemsResponse) Descriptor() ([]byte, []int) {
+	return file_items_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListItemsResponse) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListItemsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ExportItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Descending    bool                   `protobuf:"varint,1,opt,name=descending,proto3" json:"descending,omitempty"`
+	RelatedId     string                 `protobuf:"bytes,2,opt,name=related_id,json=relatedId,proto3" json:"related_id,omitempty"`
+	AtomId        string                 `protobuf:"bytes,3,opt,name=atom_id,json=atomId,proto3" json:"atom_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportItemsRequest) Reset() {
+	*x = ExportItemsRequest{}
+	mi := &file_items_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportItemsRequest) ProtoMessage() {}
+
+func (x *ExportItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_items_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportItemsRequest.ProtoReflect.Descriptor instead.
+func (*ExportItemsRequest) Descriptor() ([]byte, []int) {
+	return file_items_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExportItemsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ExportItemsRequest) GetRelatedId() string {
+	if x != nil {
+		return x.RelatedId
+	}
+	return ""
+}
+
+func (x *ExportItemsRequest) GetAtomId() string {
+	if x != nil {
+		return x.AtomId
+	}
+	return ""
+}
+
+type WatchItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ids ограничивает события документами с этими ID, пустой список означает все документы
+	Ids           []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchItemsRequest) Reset() {
+	*x = WatchItemsRequest{}
+	mi := &file_items_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchItemsRequest) ProtoMessage() {}
+
+func (x *WatchItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_items_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchItemsRequest.ProtoReflect.Descriptor instead.
+func (*WatchItemsRequest) Descriptor() ([]byte, []int) {
+	return file_items_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WatchItemsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ItemEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  ItemEvent_Type         `protobuf:"varint,1,opt,name=type,proto3,enum=crud.items.v1.ItemEvent_Type" json:"type,omitempty"`
+	Id    string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// item актуальная версия документа, не заполняется для удаленных документов
+	Item          *Item                  `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemEvent) Reset() {
+	*x = ItemEvent{}
+	mi := &file_items_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemEvent) ProtoMessage() {}
+
+func (x *ItemEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_items_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemEvent.ProtoReflect.Descriptor instead.
+func (*ItemEvent) Descriptor() ([]byte, []int) {
+	return file_items_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ItemEvent) GetType() ItemEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ItemEvent_TYPE_UNSPECIFIED
+}
+
+func (x *ItemEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ItemEvent) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *ItemEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+var File_items_proto protoreflect.FileDescriptor
+
+const file_items_proto_rawDesc = "" +
+	"\n" +
+	"\vitems.proto\x12\rcrud.items.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"*\n" +
+	"\x04Atom\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"o\n" +
+	"\x06Nested\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\x03R\x04sort\x12-\n" +
+	"\arelated\x18\x04 \x03(\v2\x13.crud.items.v1.AtomR\arelated\"\xe5\x01\n" +
+	"\x04Item\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\x03R\x04sort\x12/\n" +
+	"\arelated\x18\x04 \x03(\v2\x15.crud.items.v1.NestedR\arelated\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"X\n" +
+	"\x11CreateItemRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
+	"\arelated\x18\x02 \x03(\v2\x15.crud.items.v1.NestedR\arelated\"$\n" +
+	"\x12CreateItemResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\" \n" +
+	"\x0eGetItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"h\n" +
+	"\x11UpdateItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12/\n" +
+	"\arelated\x18\x03 \x03(\v2\x15.crud.items.v1.NestedR\arelated\"#\n" +
+	"\x11DeleteItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"x\n" +
+	"\x10ListItemsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1d\n" +
+	"\n" +
+	"related_id\x18\x03 \x01(\tR\trelatedId\x12\x17\n" +
+	"\aatom_id\x18\x04 \x01(\tR\x06atomId\"T\n" +
+	"\x11ListItemsResponse\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.crud.items.v1.ItemR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"l\n" +
+	"\x12ExportItemsRequest\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x01 \x01(\bR\n" +
+	"descending\x12\x1d\n" +
+	"\n" +
+	"related_id\x18\x02 \x01(\tR\trelatedId\x12\x17\n" +
+	"\aatom_id\x18\x03 \x01(\tR\x06atomId\"%\n" +
+	"\x11WatchItemsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"\xfb\x01\n" +
+	"\tItemEvent\x121\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1d.crud.items.v1.ItemEvent.TypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12'\n" +
+	"\x04item\x18\x03 \x01(\v2\x13.crud.items.v1.ItemR\x04item\x12.\n" +
+	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"R\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fTYPE_CREATED\x10\x01\x12\x10\n" +
+	"\fTYPE_UPDATED\x10\x02\x12\x10\n" +
+	"\fTYPE_DELETED\x10\x032\x94\x04\n" +
+	"\vItemService\x12Q\n" +
+	"\n" +
+	"CreateItem\x12 .crud.items.v1.CreateItemRequest\x1a!.crud.items.v1.CreateItemResponse\x12=\n" +
+	"\aGetItem\x12\x1d.crud.items.v1.GetItemRequest\x1a\x13.crud.items.v1.Item\x12F\n" +
+	"\n" +
+	"UpdateItem\x12 .crud.items.v1.UpdateItemRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\n" +
+	"DeleteItem\x12 .crud.items.v1.DeleteItemRequest\x1a\x16.google.protobuf.Empty\x12N\n" +
+	"\tListItems\x12\x1f.crud.items.v1.ListItemsRequest\x1a .crud.items.v1.ListItemsResponse\x12G\n" +
+	"\vExportItems\x12!.crud.items.v1.ExportItemsRequest\x1a\x13.crud.items.v1.Item0\x01\x12J\n" +
+	"\n" +
+	"WatchItems\x12 .crud.items.v1.WatchItemsRequest\x1a\x18.crud.items.v1.ItemEvent0\x01B\x1dZ\x1bcrud/internal/api/grpc;grpcb\x06proto3"
+
+var (
+	file_items_proto_rawDescOnce sync.Once
+	file_items_proto_rawDescData []byte
+)
+
+func file_items_proto_rawDescGZIP() []byte {
+	file_items_proto_rawDescOnce.Do(func() {
+		file_items_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_items_proto_rawDesc), len(file_items_proto_rawDesc)))
+	})
+	return file_items_proto_rawDescData
+}
+
+var file_items_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_items_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_items_proto_goTypes = []any{
+	(ItemEvent_Type)(0),           // 0: crud.items.v1.ItemEvent.Type
+	(*Atom)(nil),                  // 1: crud.items.v1.Atom
+	(*Nested)(nil),                // 2: crud.items.v1.Nested
+	(*Item)(nil),                  // 3: crud.items.v1.Item
+	(*CreateItemRequest)(nil),     // 4: crud.items.v1.CreateItemRequest
+	(*CreateItemResponse)(nil),    // 5: crud.items.v1.CreateItemResponse
+	(*GetItemRequest)(nil),        // 6: crud.items.v1.GetItemRequest
+	(*UpdateItemRequest)(nil),     // 7: crud.items.v1.UpdateItemRequest
+	(*DeleteItemRequest)(nil),     // 8: crud.items.v1.DeleteItemRequest
+	(*ListItemsRequest)(nil),      // 9: crud.items.v1.ListItemsRequest
+	(*ListItemsResponse)(nil),     // 10: crud.items.v1.ListItemsResponse
+	(*ExportItemsRequest)(nil),    // 11: crud.items.v1.ExportItemsRequest
+	(*WatchItemsRequest)(nil),     // 12: crud.items.v1.WatchItemsRequest
+	(*ItemEvent)(nil),             // 13: crud.items.v1.ItemEvent
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 15: google.protobuf.Empty
+}
+var file_items_proto_depIdxs = []int32{
+	1,  // 0: crud.items.v1.Nested.related:type_name -> crud.items.v1.Atom
+	2,  // 1: crud.items.v1.Item.related:type_name -> crud.items.v1.Nested
+	14, // 2: crud.items.v1.Item.created_at:type_name -> google.protobuf.Timestamp
+	14, // 3: crud.items.v1.Item.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 4: crud.items.v1.CreateItemRequest.related:type_name -> crud.items.v1.Nested
+	2,  // 5: crud.items.v1.UpdateItemRequest.related:type_name -> crud.items.v1.Nested
+	3,  // 6: crud.items.v1.ListItemsResponse.items:type_name -> crud.items.v1.Item
+	0,  // 7: crud.items.v1.ItemEvent.type:type_name -> crud.items.v1.ItemEvent.Type
+	3,  // 8: crud.items.v1.ItemEvent.item:type_name -> crud.items.v1.Item
+	14, // 9: crud.items.v1.ItemEvent.time:type_name -> google.protobuf.Timestamp
+	4,  // 10: crud.items.v1.ItemService.CreateItem:input_type -> crud.items.v1.CreateItemRequest
+	6,  // 11: crud.items.v1.ItemService.GetItem:input_type -> crud.items.v1.GetItemRequest
+	7,  // 12: crud.items.v1.ItemService.UpdateItem:input_type -> crud.items.v1.UpdateItemRequest
+	8,  // 13: crud.items.v1.ItemService.DeleteItem:input_type -> crud.items.v1.DeleteItemRequest
+	9,  // 14: crud.items.v1.ItemService.ListItems:input_type -> crud.items.v1.ListItemsRequest
+	11, // 15: crud.items.v1.ItemService.ExportItems:input_type -> crud.items.v1.ExportItemsRequest
+	12, // 16: crud.items.v1.ItemService.WatchItems:input_type -> crud.items.v1.WatchItemsRequest
+	5,  // 17: crud.items.v1.ItemService.CreateItem:output_type -> crud.items.v1.CreateItemResponse
+	3,  // 18: crud.items.v1.ItemService.GetItem:output_type -> crud.items.v1.Item
+	15, // 19: crud.items.v1.ItemService.UpdateItem:output_type -> google.protobuf.Empty
+	15, // 20: crud.items.v1.ItemService.DeleteItem:output_type -> google.protobuf.Empty
+	10, // 21: crud.items.v1.ItemService.ListItems:output_type -> crud.items.v1.ListItemsResponse
+	3,  // 22: crud.items.v1.ItemService.ExportItems:output_type -> crud.items.v1.Item
+	13, // 23: crud.items.v1.ItemService.WatchItems:output_type -> crud.items.v1.ItemEvent
+	17, // [17:24] is the sub-list for method output_type
+	10, // [10:17] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_items_proto_init() }
+func file_items_proto_init() {
+	if File_items_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_items_proto_rawDesc), len(file_items_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_items_proto_goTypes,
+		DependencyIndexes: file_items_proto_depIdxs,
+		EnumInfos:         file_items_proto_enumTypes,
+		MessageInfos:      file_items_proto_msgTypes,
+	}.Build()
+	File_items_proto = out.File
+	file_items_proto_goTypes = nil
+	file_items_proto_depIdxs = nil
+}
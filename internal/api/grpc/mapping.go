@@ -0,0 +1,115 @@
+package grpc
+
+import (
+	"crud/internal/domain"
+	"fmt"
+	"github.com/gofrs/uuid/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func parseID(field, value string) (uuid.UUID, error) {
+	id, err := uuid.FromString(value)
+	if err != nil {
+		return id, domain.Validation(domain.FieldError{Field: field, Reason: "has invalid format"})
+	}
+
+	return id, nil
+}
+
+func parseFilter(relatedID, atomID string) (domain.ItemFilter, error) {
+	var (
+		filter domain.ItemFilter
+		err    error
+	)
+	if relatedID != "" {
+		if filter.RelatedID, err = parseID("related_id", relatedID); err != nil {
+			return filter, err
+		}
+	}
+	if atomID != "" {
+		if filter.AtomID, err = parseID("atom_id", atomID); err != nil {
+			return filter, err
+		}
+	}
+
+	return filter, nil
+}
+
+func relatedToDomain(path string, related []*Nested) ([]domain.Nested, error) {
+	var fields []domain.FieldError
+
+	nested := make([]domain.Nested, 0, len(related))
+	for i, nst := range related {
+		nstPath := fmt.Sprintf("%s[%d]", path, i)
+		nstID, err := uuid.FromString(nst.GetId())
+		if err != nil {
+			fields = append(fields, domain.FieldError{Field: nstPath + ".id", Reason: "has invalid format"})
+		}
+
+		atoms := make([]domain.Atom, 0, len(nst.GetRelated()))
+		for j, atom := range nst.GetRelated() {
+			atomID, err := uuid.FromString(atom.GetId())
+			if err != nil {
+				fields = append(fields, domain.FieldError{Field: fmt.Sprintf("%s.related[%d].id", nstPath, j), Reason: "has invalid format"})
+			}
+			atoms = append(atoms, domain.Atom{ID: atomID, Name: atom.GetName()})
+		}
+
+		nested = append(nested, domain.Nested{
+			ID:      nstID,
+			Name:    nst.GetName(),
+			Sort:    nst.GetSort(),
+			Related: atoms,
+		})
+	}
+
+	if len(fields) > 0 {
+		return nil, domain.Validation(fields...)
+	}
+
+	return nested, nil
+}
+
+func itemToProto(item domain.Item) *Item {
+	related := make([]*Nested, 0, len(item.Related))
+	for _, nst := range item.Related {
+		atoms := make([]*Atom, 0, len(nst.Related))
+		for _, atom := range nst.Related {
+			atoms = append(atoms, &Atom{Id: atom.ID.String(), Name: atom.Name})
+		}
+
+		related = append(related, &Nested{
+			Id:      nst.ID.String(),
+			Name:    nst.Name,
+			Sort:    nst.Sort,
+			Related: atoms,
+		})
+	}
+
+	res := &Item{
+		Id:        item.ID.String(),
+		Name:      item.Name,
+		Sort:      item.Sort,
+		Related:   related,
+		CreatedAt: timestamppb.New(item.CreatedAt),
+	}
+	if item.UpdatedAt != nil {
+		res.UpdatedAt = timestamppb.New(*item.UpdatedAt)
+	}
+
+	return res
+}
+
+var eventTypes = map[domain.EventType]ItemEvent_Type{
+	domain.EventCreated: ItemEvent_TYPE_CREATED,
+	domain.EventUpdated: ItemEvent_TYPE_UPDATED,
+	domain.EventDeleted: ItemEvent_TYPE_DELETED,
+}
+
+func eventToProto(event domain.ItemEvent) *ItemEvent {
+	return &ItemEvent{
+		Type: eventTypes[event.Type],
+		Id:   event.ItemID.String(),
+		Time: timestamppb.New(event.At),
+	}
+}
@@ -0,0 +1,121 @@
+package grpc
+
+import (
+	"context"
+	"crud/internal/domain"
+	"github.com/gofrs/uuid/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"iter"
+	"log/slog"
+)
+
+type service interface {
+	CreateItem(ctx context.Context, item domain.Item) (uuid.UUID, error)
+	GetItem(ctx context.Context, id uuid.UUID) (domain.Item, bool, error)
+	GetItemsPaginated(ctx context.Context, filter domain.ItemFilter, pagination domain.Pagination) ([]domain.Item, int64, error)
+	UpdateItem(ctx context.Context, id uuid.UUID, item domain.Item) error
+	DeleteItem(ctx context.Context, id uuid.UUID) error
+	ExportItems(ctx context.Context, filter domain.ItemFilter, order domain.SortOrder) iter.Seq2[domain.Item, error]
+	Subscribe(ctx context.Context, filter domain.EventFilter) <-chan domain.ItemEvent
+}
+
+type Server struct {
+	UnimplementedItemServiceServer
+
+	Service service
+	Logger  *slog.Logger
+}
+
+func (s Server) CreateItem(ctx context.Context, req *CreateItemRequest) (*CreateItemResponse, error) {
+	related, err := relatedToDomain("related", req.GetRelated())
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.Service.CreateItem(ctx, domain.Item{
+		Name:    req.GetName(),
+		Related: related,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateItemResponse{Id: id.String()}, nil
+}
+
+func (s Server) GetItem(ctx context.Context, req *GetItemRequest) (*Item, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	item, ok, err := s.Service.GetItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, domain.NotFound("item %s not found", id)
+	}
+
+	return itemToProto(item), nil
+}
+
+func (s Server) UpdateItem(ctx context.Context, req *UpdateItemRequest) (*emptypb.Empty, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	related, err := relatedToDomain("related", req.GetRelated())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Service.UpdateItem(ctx, id, domain.Item{
+		Name:    req.GetName(),
+		Related: related,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s Server) DeleteItem(ctx context.Context, req *DeleteItemRequest) (*emptypb.Empty, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Service.DeleteItem(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s Server) ListItems(ctx context.Context, req *ListItemsRequest) (*ListItemsResponse, error) {
+	filter, err := parseFilter(req.GetRelatedId(), req.GetAtomId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetLimit() < 1 {
+		return nil, domain.Validation(domain.FieldError{Field: "limit", Reason: "must be positive"})
+	}
+
+	items, total, err := s.Service.GetItemsPaginated(ctx, filter, domain.Pagination{
+		Limit:  int(req.GetLimit()),
+		Offset: int(max(req.GetOffset(), 0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*Item, 0, len(items))
+	for _, it := range items {
+		res = append(res, itemToProto(it))
+	}
+
+	return &ListItemsResponse{Items: res, Total: total}, nil
+}
@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"crud/internal/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ExportItems отправляет документы по одному: Send блокируется, пока клиент не освободит окно потока,
+// и следующий документ не читается из базы до завершения отправки предыдущего
+func (s Server) ExportItems(req *ExportItemsRequest, stream grpc.ServerStreamingServer[Item]) error {
+	filter, err := parseFilter(req.GetRelatedId(), req.GetAtomId())
+	if err != nil {
+		return err
+	}
+
+	order := domain.OrderAsc
+	if req.GetDescending() {
+		order = domain.OrderDesc
+	}
+
+	for item, err := range s.Service.ExportItems(stream.Context(), filter, order) {
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(itemToProto(item)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WatchItems отправляет события, пока клиент не закроет поток. Для созданных и измененных документов
+// к событию прикладывается актуальная версия документа
+func (s Server) WatchItems(req *WatchItemsRequest, stream grpc.ServerStreamingServer[ItemEvent]) error {
+	var filter domain.EventFilter
+	for _, raw := range req.GetIds() {
+		id, err := parseID("ids", raw)
+		if err != nil {
+			return err
+		}
+		filter.ItemIDs = append(filter.ItemIDs, id)
+	}
+
+	ctx := stream.Context()
+	events := s.Service.Subscribe(ctx, filter)
+	// заголовки отправляются после подписки: получив их, клиент может быть уверен, что не пропустит события
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		var (
+			event domain.ItemEvent
+			ok    bool
+		)
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case event, ok = <-events:
+		}
+		if !ok {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			return status.Error(codes.ResourceExhausted, "watcher fell behind the event stream, resubscribe")
+		}
+
+		msg := eventToProto(event)
+		if event.Type != domain.EventDeleted {
+			item, found, err := s.Service.GetItem(ctx, event.ItemID)
+			if err != nil {
+				return err
+			}
+			if found {
+				msg.Item = itemToProto(item)
+			}
+		}
+
+		if err := stream.Send(msg); err != nil {
+			return err
+		}
+	}
+}
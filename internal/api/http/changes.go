@@ -0,0 +1,50 @@
+package http
+
+import (
+	"context"
+	"crud/internal/domain"
+	"fmt"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	defaultChangesLimit = 100
+	maxChangesLimit     = 1000
+)
+
+func (s Server) GetItemsChanges(ctx context.Context, request GetItemsChangesRequestObject) (GetItemsChangesResponseObject, error) {
+	var since string
+	if request.Params.Since != nil {
+		since = *request.Params.Since
+	}
+
+	limit := defaultChangesLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if limit < 1 || limit > maxChangesLimit {
+		return nil, domain.Validation(domain.FieldError{Field: "limit", Reason: fmt.Sprintf("must be between 1 and %d", maxChangesLimit)})
+	}
+
+	set, err := s.Service.GetChanges(ctx, since, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Item, 0, len(set.Items))
+	for _, item := range set.Items {
+		items = append(items, itemToResponse(item))
+	}
+
+	tombstones := make([]Tombstone, 0, len(set.Tombstones))
+	for _, t := range set.Tombstones {
+		tombstones = append(tombstones, Tombstone{Id: openapitypes.UUID(t.ID), DeletedAt: t.DeletedAt})
+	}
+
+	return GetItemsChanges200JSONResponse{
+		Items:      items,
+		Tombstones: tombstones,
+		Next:       set.Next,
+		HasMore:    set.HasMore,
+	}, nil
+}
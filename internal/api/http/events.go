@@ -0,0 +1,118 @@
+package http
+
+import (
+	"context"
+	"crud/internal/domain"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gofrs/uuid/v5"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"net/http"
+	"time"
+)
+
+// defaultEventsHeartbeat период комментариев-пульсов, если он не задан в конфигурации
+const defaultEventsHeartbeat = 15 * time.Second
+
+var eventTypes = map[ItemEventType]domain.EventType{
+	ItemEventTypeCreated: domain.EventCreated,
+	ItemEventTypeUpdated: domain.EventUpdated,
+	ItemEventTypeDeleted: domain.EventDeleted,
+}
+
+func (s Server) GetItemsEvents(ctx context.Context, request GetItemsEventsRequestObject) (GetItemsEventsResponseObject, error) {
+	params := request.Params
+
+	var filter domain.EventFilter
+	if params.Ids != nil {
+		for _, id := range *params.Ids {
+			filter.ItemIDs = append(filter.ItemIDs, uuid.UUID(id))
+		}
+	}
+	if params.Types != nil {
+		for i, t := range *params.Types {
+			eventType, ok := eventTypes[t]
+			if !ok {
+				return nil, domain.Validation(domain.FieldError{Field: fmt.Sprintf("types[%d]", i), Reason: "has invalid value"})
+			}
+			filter.Types = append(filter.Types, eventType)
+		}
+	}
+
+	stream := eventStream{ctx: ctx, stopping: s.Stopping, heartbeat: s.EventsHeartbeat, complete: true}
+	if stream.heartbeat <= 0 {
+		stream.heartbeat = defaultEventsHeartbeat
+	}
+	if params.LastEventID != nil {
+		stream.events, stream.complete = s.Service.Resume(ctx, filter, *params.LastEventID)
+	} else {
+		stream.events = s.Service.Subscribe(ctx, filter)
+	}
+
+	return stream, nil
+}
+
+// eventStream ответ text/event-stream, события записываются по мере поступления до отключения клиента
+// или остановки сервера
+type eventStream struct {
+	ctx       context.Context
+	stopping  <-chan struct{}
+	events    <-chan domain.ItemEvent
+	complete  bool
+	heartbeat time.Duration
+}
+
+func (e eventStream) VisitGetItemsEventsResponse(w http.ResponseWriter) error {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return errors.New("response writer does not support streaming")
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if !e.complete {
+		// часть пропущенных событий уже не хранится, клиенту нужно перечитать документы
+		_, _ = fmt.Fprint(w, "event: reset\ndata: {}\n\n")
+	}
+	flusher.Flush()
+
+	ticker := time.NewTicker(e.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-e.ctx.Done():
+			return nil
+		case <-e.stopping:
+			// клиент переподключится к другому экземпляру с Last-Event-ID
+			return nil
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+		case event, ok := <-e.events:
+			if !ok {
+				// подписчик отстал, клиент переподключится с Last-Event-ID и получит пропущенное из истории
+				return nil
+			}
+
+			data, err := json.Marshal(ItemEvent{
+				Id:     event.Seq,
+				Type:   ItemEventType(event.Type),
+				ItemId: openapitypes.UUID(event.ItemID),
+				Time:   event.At,
+			})
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Seq, event.Type, data); err != nil {
+				return nil
+			}
+		}
+		flusher.Flush()
+	}
+}
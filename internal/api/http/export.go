@@ -0,0 +1,235 @@
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crud/internal/domain"
+	"crud/internal/transfer"
+	"encoding/json"
+	"github.com/gofrs/uuid/v5"
+	"io"
+	"iter"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// exportFlushItems количество документов выгрузки, после которого данные отправляются клиенту
+const exportFlushItems = 100
+
+func (s Server) GetItemsExport(ctx context.Context, request GetItemsExportRequestObject) (GetItemsExportResponseObject, error) {
+	params := request.Params
+
+	var filter domain.ItemFilter
+	if params.RelatedId != nil {
+		filter.RelatedID = uuid.UUID(*params.RelatedId)
+	}
+	if params.AtomId != nil {
+		filter.AtomID = uuid.UUID(*params.AtomId)
+	}
+
+	mode := GetItemsExportParamsModeTransformed
+	if params.Mode != nil {
+		mode = *params.Mode
+	}
+
+	stream := exportStream{contentType: "application/x-ndjson", logger: s.logger(ctx)}
+	switch mode {
+	case GetItemsExportParamsModeTransformed:
+		stream.chunks = itemLines(s.Service.ExportItems(ctx, filter, domain.OrderAsc))
+	case GetItemsExportParamsModeRaw:
+		stream.chunks = itemLines(s.Service.ExportRawItems(ctx, filter))
+	default:
+		return nil, domain.Validation(domain.FieldError{Field: "mode", Reason: "has invalid value"})
+	}
+	if params.AcceptEncoding != nil {
+		stream.gzip = acceptsGzip(*params.AcceptEncoding)
+	}
+
+	return stream, nil
+}
+
+// itemLines кодирует документы так же, как ответы API, по одному на строку
+func itemLines(items iter.Seq2[domain.Item, error]) iter.Seq2[[]byte, error] {
+	return func(yield func([]byte, error) bool) {
+		for item, err := range items {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			line, err := json.Marshal(itemToResponse(item))
+			if !yield(append(line, '\n'), err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// acceptsGzip проверяет, перечислен ли gzip в заголовке Accept-Encoding без нулевого веса
+func acceptsGzip(header string) bool {
+	for _, part := range strings.Split(header, ",") {
+		coding, weight, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if !strings.EqualFold(strings.TrimSpace(coding), "gzip") {
+			continue
+		}
+
+		q, found := strings.CutPrefix(strings.TrimSpace(weight), "q=")
+		if !found {
+			return true
+		}
+		value, err := strconv.ParseFloat(q, 64)
+		return err == nil && value > 0
+	}
+
+	return false
+}
+
+// exportStream ответ с выгрузкой, данные записываются по мере чтения документов из базы. Каждая часть chunks
+// содержит один документ, prefix записывается перед ними
+type exportStream struct {
+	contentType string
+	filename    string
+	prefix      []byte
+	chunks      iter.Seq2[[]byte, error]
+	gzip        bool
+	logger      *slog.Logger
+}
+
+func (e exportStream) VisitGetItemsExportResponse(w http.ResponseWriter) error {
+	return e.visit(w)
+}
+
+func (e exportStream) VisitGetItemsExportCsvResponse(w http.ResponseWriter) error {
+	return e.visit(w)
+}
+
+func (e exportStream) visit(w http.ResponseWriter) error {
+	next, stop := iter.Pull2(e.chunks)
+	defer stop()
+
+	// первый документ читается до отправки заголовков, чтобы ошибка базы вернулась клиенту обычным ответом
+	chunk, err, ok := next()
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", e.contentType)
+	if e.filename != "" {
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": e.filename}))
+	}
+	w.Header().Add("Vary", "Accept-Encoding")
+	if e.gzip {
+		w.Header().Set("Content-Encoding", "gzip")
+	}
+	w.WriteHeader(http.StatusOK)
+
+	var out io.Writer = w
+	flush := func() {}
+	if flusher, ok := w.(http.Flusher); ok {
+		flush = flusher.Flush
+	}
+	if e.gzip {
+		gz := gzip.NewWriter(w)
+		defer gz.Close()
+
+		out = gz
+		httpFlush := flush
+		flush = func() {
+			_ = gz.Flush()
+			httpFlush()
+		}
+	}
+
+	if _, err := out.Write(e.prefix); err != nil {
+		return nil
+	}
+	for n := 1; ok; n++ {
+		if _, err := out.Write(chunk); err != nil {
+			// клиент отключился
+			return nil
+		}
+		if n%exportFlushItems == 0 {
+			flush()
+		}
+
+		chunk, err, ok = next()
+		if err != nil {
+			// статус уже отправлен, обрыв соединения не даст принять неполную выгрузку за полную
+			e.logger.Error(err.Error(), "path", "export")
+			panic(http.ErrAbortHandler)
+		}
+	}
+
+	return nil
+}
+
+func (s Server) GetItemsExportCsv(ctx context.Context, request GetItemsExportCsvRequestObject) (GetItemsExportCsvResponseObject, error) {
+	params := request.Params
+
+	var filter domain.ItemFilter
+	if params.RelatedId != nil {
+		filter.RelatedID = uuid.UUID(*params.RelatedId)
+	}
+	if params.AtomId != nil {
+		filter.AtomID = uuid.UUID(*params.AtomId)
+	}
+
+	layout := transfer.LayoutAtom
+	if params.Layout != nil {
+		layout = transfer.CSVLayout(*params.Layout)
+	}
+	var columns []string
+	if params.Columns != nil {
+		columns = *params.Columns
+	}
+
+	var buf bytes.Buffer
+	encoder, err := transfer.NewCSVEncoder(&buf, columns, layout)
+	if err != nil {
+		return nil, err
+	}
+	if err := encoder.WriteHeader(params.Bom != nil && *params.Bom); err != nil {
+		return nil, err
+	}
+	if err := encoder.Flush(); err != nil {
+		return nil, err
+	}
+
+	return exportStream{
+		contentType: "text/csv; charset=utf-8",
+		filename:    "items.csv",
+		prefix:      bytes.Clone(buf.Bytes()),
+		chunks:      csvRows(s.Service.ExportItems(ctx, filter, domain.OrderAsc), encoder, &buf),
+		logger:      s.logger(ctx),
+	}, nil
+}
+
+// csvRows кодирует каждый документ в buf и отдает получившиеся строки. Буфер очищается перед следующим документом,
+// поэтому часть действительна только до следующего шага обхода
+func csvRows(items iter.Seq2[domain.Item, error], encoder *transfer.CSVEncoder, buf *bytes.Buffer) iter.Seq2[[]byte, error] {
+	return func(yield func([]byte, error) bool) {
+		for item, err := range items {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			buf.Reset()
+			if err := encoder.Encode(item); err != nil {
+				yield(nil, err)
+				return
+			}
+			if err := encoder.Flush(); err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(buf.Bytes(), nil) {
+				return
+			}
+		}
+	}
+}
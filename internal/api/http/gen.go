@@ -12,6 +12,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -24,6 +25,52 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for HealthStatus.
+const (
+	HealthStatusFail HealthStatus = "fail"
+	HealthStatusPass HealthStatus = "pass"
+)
+
+// Defines values for ImportLineStatus.
+const (
+	ImportLineStatusCreated  ImportLineStatus = "created"
+	ImportLineStatusRejected ImportLineStatus = "rejected"
+	ImportLineStatusSkipped  ImportLineStatus = "skipped"
+	ImportLineStatusUpdated  ImportLineStatus = "updated"
+)
+
+// Defines values for ItemEventType.
+const (
+	ItemEventTypeCreated ItemEventType = "created"
+	ItemEventTypeDeleted ItemEventType = "deleted"
+	ItemEventTypeUpdated ItemEventType = "updated"
+)
+
+// Defines values for GetItemsExportParamsMode.
+const (
+	GetItemsExportParamsModeRaw         GetItemsExportParamsMode = "raw"
+	GetItemsExportParamsModeTransformed GetItemsExportParamsMode = "transformed"
+)
+
+// Defines values for GetItemsExportCsvParamsLayout.
+const (
+	GetItemsExportCsvParamsLayoutAtom   GetItemsExportCsvParamsLayout = "atom"
+	GetItemsExportCsvParamsLayoutNested GetItemsExportCsvParamsLayout = "nested"
+)
+
+// Defines values for PostItemsImportParamsFormat.
+const (
+	PostItemsImportParamsFormatCsv    PostItemsImportParamsFormat = "csv"
+	PostItemsImportParamsFormatNdjson PostItemsImportParamsFormat = "ndjson"
+)
+
+// Defines values for PostItemsImportParamsPolicy.
+const (
+	PostItemsImportParamsPolicyFail      PostItemsImportParamsPolicy = "fail"
+	PostItemsImportParamsPolicyOverwrite PostItemsImportParamsPolicy = "overwrite"
+	PostItemsImportParamsPolicySkip      PostItemsImportParamsPolicy = "skip"
+)
+
 // Atom defines model for Atom.
 type Atom struct {
 	// Id UUID
@@ -31,9 +78,90 @@ type Atom struct {
 	Name string             `json:"name"`
 }
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Error *string `json:"error,omitempty"`
+// AtomUpdate defines model for AtomUpdate.
+type AtomUpdate struct {
+	Name string `json:"name"`
+}
+
+// ChangeSet defines model for ChangeSet.
+type ChangeSet struct {
+	// HasMore More changes are available right away
+	HasMore bool   `json:"has_more"`
+	Items   []Item `json:"items"`
+
+	// Next Sync token for the next request
+	Next       string      `json:"next"`
+	Tombstones []Tombstone `json:"tombstones"`
+}
+
+// Delivery defines model for Delivery.
+type Delivery struct {
+	Attempts int `json:"attempts"`
+
+	// Event Body of a webhook delivery
+	Event         WebhookPayload     `json:"event"`
+	Id            openapi_types.UUID `json:"id"`
+	LastError     *string            `json:"last_error,omitempty"`
+	NextAttemptAt time.Time          `json:"next_attempt_at"`
+	WebhookId     openapi_types.UUID `json:"webhook_id"`
+}
+
+// DeliveryList defines model for DeliveryList.
+type DeliveryList struct {
+	Deliveries []Delivery `json:"deliveries"`
+}
+
+// HealthCheck defines model for HealthCheck.
+type HealthCheck struct {
+	Error     *string      `json:"error,omitempty"`
+	LatencyMs float64      `json:"latency_ms"`
+	Name      string       `json:"name"`
+	Status    HealthStatus `json:"status"`
+}
+
+// HealthReport defines model for HealthReport.
+type HealthReport struct {
+	Checks  []HealthCheck `json:"checks"`
+	Status  HealthStatus  `json:"status"`
+	Version string        `json:"version"`
+}
+
+// HealthStatus defines model for HealthStatus.
+type HealthStatus string
+
+// ImportLine defines model for ImportLine.
+type ImportLine struct {
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// Line Line the record starts at
+	Line   int              `json:"line"`
+	Reason *string          `json:"reason,omitempty"`
+	Status ImportLineStatus `json:"status"`
+}
+
+// ImportLineStatus defines model for ImportLine.Status.
+type ImportLineStatus string
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	// Aborted The import was stopped by a conflict, the records after it were not processed
+	Aborted bool `json:"aborted"`
+
+	// Accepted Number of created and updated items
+	Accepted int `json:"accepted"`
+
+	// DryRun Nothing was written, the report shows what the import would do
+	DryRun   bool         `json:"dry_run"`
+	Lines    []ImportLine `json:"lines"`
+	Rejected int          `json:"rejected"`
+	Skipped  int          `json:"skipped"`
+}
+
+// InvalidParam defines model for InvalidParam.
+type InvalidParam struct {
+	// Name Path to the invalid field
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
 }
 
 // Item defines model for Item.
@@ -52,6 +180,25 @@ type Item struct {
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
+// ItemBatch defines model for ItemBatch.
+type ItemBatch struct {
+	// Results Results in the order of the requested UUIDs
+	Results []ItemBatchResult `json:"results"`
+}
+
+// ItemBatchRequest defines model for ItemBatchRequest.
+type ItemBatchRequest struct {
+	Ids []openapi_types.UUID `json:"ids"`
+}
+
+// ItemBatchResult defines model for ItemBatchResult.
+type ItemBatchResult struct {
+	// Found False if there is no item with this UUID
+	Found bool               `json:"found"`
+	Id    openapi_types.UUID `json:"id"`
+	Item  *Item              `json:"item,omitempty"`
+}
+
 // ItemCreate defines model for ItemCreate.
 type ItemCreate struct {
 	// Name Item name
@@ -59,6 +206,27 @@ type ItemCreate struct {
 	Related *[]Nested `json:"related,omitempty"`
 }
 
+// ItemEvent defines model for ItemEvent.
+type ItemEvent struct {
+	// Id Sequence number of the event
+	Id     uint64             `json:"id"`
+	ItemId openapi_types.UUID `json:"item_id"`
+	Time   time.Time          `json:"time"`
+	Type   ItemEventType      `json:"type"`
+}
+
+// ItemEventType defines model for ItemEventType.
+type ItemEventType string
+
+// ItemList defines model for ItemList.
+type ItemList struct {
+	Items []Item `json:"items"`
+
+	// Results Only for a request with `ids`, results in the order of the requested UUIDs
+	Results *[]ItemBatchResult `json:"results,omitempty"`
+	Total   *int               `json:"total,omitempty"`
+}
+
 // ItemUpdate defines model for ItemUpdate.
 type ItemUpdate struct {
 	// Name Item name
@@ -66,12 +234,42 @@ type ItemUpdate struct {
 	Related []Nested `json:"related"`
 }
 
+// Liveness defines model for Liveness.
+type Liveness struct {
+	Status  string `json:"status"`
+	Version string `json:"version"`
+}
+
 // Nested defines model for Nested.
 type Nested struct {
 	// Id UUID
 	Id      openapi_types.UUID `json:"id"`
 	Name    string             `json:"name"`
 	Related *[]Atom            `json:"related,omitempty"`
+
+	// Sort Position of the nested document, higher goes first
+	Sort *int64 `json:"sort,omitempty"`
+}
+
+// NestedUpdate defines model for NestedUpdate.
+type NestedUpdate struct {
+	Name    string `json:"name"`
+	Related []Atom `json:"related"`
+	Sort    *int64 `json:"sort,omitempty"`
+}
+
+// Problem Error description according to RFC 7807
+type Problem struct {
+	Detail        *string         `json:"detail,omitempty"`
+	ImportReport  *ImportReport   `json:"import_report,omitempty"`
+	Instance      *string         `json:"instance,omitempty"`
+	InvalidParams *[]InvalidParam `json:"invalid_params,omitempty"`
+
+	// RequestId ID of the request, the same as in the X-Request-ID response header and in the server logs
+	RequestId *string `json:"request_id,omitempty"`
+	Status    int     `json:"status"`
+	Title     string  `json:"title"`
+	Type      string  `json:"type"`
 }
 
 // SuccessResponse defines model for SuccessResponse.
@@ -81,26 +279,182 @@ type SuccessResponse struct {
 	Message *string                 `json:"message,omitempty"`
 }
 
+// Tombstone defines model for Tombstone.
+type Tombstone struct {
+	DeletedAt time.Time          `json:"deleted_at"`
+	Id        openapi_types.UUID `json:"id"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Events    []ItemEventType    `json:"events"`
+	Id        openapi_types.UUID `json:"id"`
+	Url       string             `json:"url"`
+}
+
+// WebhookCreate defines model for WebhookCreate.
+type WebhookCreate struct {
+	// Events Event types to deliver, all types when omitted
+	Events *[]ItemEventType `json:"events,omitempty"`
+
+	// Secret Key of the HMAC-SHA256 delivery signature
+	Secret string `json:"secret"`
+	Url    string `json:"url"`
+}
+
+// WebhookList defines model for WebhookList.
+type WebhookList struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// WebhookPayload Body of a webhook delivery
+type WebhookPayload struct {
+	// Id Event ID, the same for every delivery of the event
+	Id     openapi_types.UUID `json:"id"`
+	ItemId openapi_types.UUID `json:"item_id"`
+	Time   time.Time          `json:"time"`
+	Type   ItemEventType      `json:"type"`
+}
+
+// AtomIDPathParameter defines model for AtomIDPathParameter.
+type AtomIDPathParameter = openapi_types.UUID
+
 // LimitQueryParameter defines model for LimitQueryParameter.
 type LimitQueryParameter = int
 
+// NestedIDPathParameter defines model for NestedIDPathParameter.
+type NestedIDPathParameter = openapi_types.UUID
+
 // OffsetQueryParameter defines model for OffsetQueryParameter.
 type OffsetQueryParameter = int
 
 // UUIDPathParameter defines model for UUIDPathParameter.
 type UUIDPathParameter = openapi_types.UUID
 
-// BadRequest defines model for BadRequest.
-type BadRequest = ErrorResponse
+// BadRequest Error description according to RFC 7807
+type BadRequest = Problem
+
+// Conflict Error description according to RFC 7807
+type Conflict = Problem
+
+// Gone Error description according to RFC 7807
+type Gone = Problem
 
-// InternalServerError defines model for InternalServerError.
-type InternalServerError = ErrorResponse
+// InternalServerError Error description according to RFC 7807
+type InternalServerError = Problem
 
-// NotFound defines model for NotFound.
-type NotFound = ErrorResponse
+// NotFound Error description according to RFC 7807
+type NotFound = Problem
+
+// PreconditionFailed Error description according to RFC 7807
+type PreconditionFailed = Problem
+
+// ServiceUnavailable Error description according to RFC 7807
+type ServiceUnavailable = Problem
 
 // GetItemsParams defines parameters for GetItems.
 type GetItemsParams struct {
+	// Limit Number of items to return, required unless `ids` is given
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip for pagination, required unless `ids` is given
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Ids Comma separated list of item UUIDs to fetch
+	Ids *[]openapi_types.UUID `form:"ids,omitempty" json:"ids,omitempty"`
+
+	// RelatedId Return only items containing the nested document with this UUID
+	RelatedId *openapi_types.UUID `form:"related_id,omitempty" json:"related_id,omitempty"`
+
+	// AtomId Return only items containing the atom with this UUID
+	AtomId *openapi_types.UUID `form:"atom_id,omitempty" json:"atom_id,omitempty"`
+}
+
+// GetItemsChangesParams defines parameters for GetItemsChanges.
+type GetItemsChangesParams struct {
+	// Since Opaque sync token from the `next` field of the previous response
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+
+	// Limit Maximum number of changes to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetItemsEventsParams defines parameters for GetItemsEvents.
+type GetItemsEventsParams struct {
+	// Ids Comma separated list of item UUIDs to receive events for
+	Ids *[]openapi_types.UUID `form:"ids,omitempty" json:"ids,omitempty"`
+
+	// Types Comma separated list of event types to receive
+	Types *[]ItemEventType `form:"types,omitempty" json:"types,omitempty"`
+
+	// LastEventID Sequence number of the last received event
+	LastEventID *uint64 `json:"Last-Event-ID,omitempty"`
+}
+
+// GetItemsExportParams defines parameters for GetItemsExport.
+type GetItemsExportParams struct {
+	// RelatedId Export only items containing the nested document with this UUID
+	RelatedId *openapi_types.UUID `form:"related_id,omitempty" json:"related_id,omitempty"`
+
+	// AtomId Export only items containing the atom with this UUID
+	AtomId *openapi_types.UUID `form:"atom_id,omitempty" json:"atom_id,omitempty"`
+
+	// Mode Representation of exported items
+	Mode *GetItemsExportParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// AcceptEncoding The response is compressed when it lists gzip
+	AcceptEncoding *string `json:"Accept-Encoding,omitempty"`
+}
+
+// GetItemsExportParamsMode defines parameters for GetItemsExport.
+type GetItemsExportParamsMode string
+
+// GetItemsExportCsvParams defines parameters for GetItemsExportCsv.
+type GetItemsExportCsvParams struct {
+	// RelatedId Export only items containing the nested document with this UUID
+	RelatedId *openapi_types.UUID `form:"related_id,omitempty" json:"related_id,omitempty"`
+
+	// AtomId Export only items containing the atom with this UUID
+	AtomId *openapi_types.UUID `form:"atom_id,omitempty" json:"atom_id,omitempty"`
+
+	// Layout What a row describes
+	Layout *GetItemsExportCsvParamsLayout `form:"layout,omitempty" json:"layout,omitempty"`
+
+	// Columns Comma separated list of columns in the order they are written, all columns by default. Available
+	// columns are `item_id`, `item_name`, `item_created_at`, `item_updated_at`, `nested_id`,
+	// `nested_name`, `nested_sort`, `atom_id` and `atom_name`
+	Columns *[]string `form:"columns,omitempty" json:"columns,omitempty"`
+
+	// Bom Start the file with the UTF-8 byte order mark, spreadsheet editors need it to detect the encoding
+	Bom *bool `form:"bom,omitempty" json:"bom,omitempty"`
+}
+
+// GetItemsExportCsvParamsLayout defines parameters for GetItemsExportCsv.
+type GetItemsExportCsvParamsLayout string
+
+// PostItemsImportParams defines parameters for PostItemsImport.
+type PostItemsImportParams struct {
+	// Format Format of the request body. By default `text/csv` content is read as CSV and any other as NDJSON
+	Format *PostItemsImportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Policy What to do when an item with the same id already exists. `skip` leaves it unchanged,
+	// `overwrite` replaces it, `fail` stops the import, the batch with the conflict is not written
+	// while the batches written before it are kept
+	Policy *PostItemsImportParamsPolicy `form:"policy,omitempty" json:"policy,omitempty"`
+
+	// DryRun Validate records and check conflicts without writing anything
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// PostItemsImportParamsFormat defines parameters for PostItemsImport.
+type PostItemsImportParamsFormat string
+
+// PostItemsImportParamsPolicy defines parameters for PostItemsImport.
+type PostItemsImportParamsPolicy string
+
+// GetRelatedIdItemsParams defines parameters for GetRelatedIdItems.
+type GetRelatedIdItemsParams struct {
 	// Limit Number of items to return
 	Limit int `form:"limit" json:"limit"`
 
@@ -108,12 +462,39 @@ type GetItemsParams struct {
 	Offset int `form:"offset" json:"offset"`
 }
 
+// GetWebhooksDeadLettersParams defines parameters for GetWebhooksDeadLetters.
+type GetWebhooksDeadLettersParams struct {
+	// Limit Number of deliveries to return
+	Limit int `form:"limit" json:"limit"`
+
+	// Offset Number of deliveries to skip for pagination
+	Offset int `form:"offset" json:"offset"`
+}
+
 // PostItemsJSONRequestBody defines body for PostItems for application/json ContentType.
 type PostItemsJSONRequestBody = ItemCreate
 
+// PostItemsBatchJSONRequestBody defines body for PostItemsBatch for application/json ContentType.
+type PostItemsBatchJSONRequestBody = ItemBatchRequest
+
 // PutItemsIdJSONRequestBody defines body for PutItemsId for application/json ContentType.
 type PutItemsIdJSONRequestBody = ItemUpdate
 
+// PostItemsIdRelatedJSONRequestBody defines body for PostItemsIdRelated for application/json ContentType.
+type PostItemsIdRelatedJSONRequestBody = Nested
+
+// PutItemsIdRelatedNestedIdJSONRequestBody defines body for PutItemsIdRelatedNestedId for application/json ContentType.
+type PutItemsIdRelatedNestedIdJSONRequestBody = NestedUpdate
+
+// PostItemsIdRelatedNestedIdRelatedJSONRequestBody defines body for PostItemsIdRelatedNestedIdRelated for application/json ContentType.
+type PostItemsIdRelatedNestedIdRelatedJSONRequestBody = Atom
+
+// PutItemsIdRelatedNestedIdRelatedAtomIdJSONRequestBody defines body for PutItemsIdRelatedNestedIdRelatedAtomId for application/json ContentType.
+type PutItemsIdRelatedNestedIdRelatedAtomIdJSONRequestBody = AtomUpdate
+
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody = WebhookCreate
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Health check
@@ -125,15 +506,87 @@ type ServerInterface interface {
 	// Create a new item
 	// (POST /items)
 	PostItems(w http.ResponseWriter, r *http.Request)
+	// Get items by UUIDs
+	// (POST /items/batch)
+	PostItemsBatch(w http.ResponseWriter, r *http.Request)
+	// Get changes since the last sync
+	// (GET /items/changes)
+	GetItemsChanges(w http.ResponseWriter, r *http.Request, params GetItemsChangesParams)
+	// Item change feed
+	// (GET /items/events)
+	GetItemsEvents(w http.ResponseWriter, r *http.Request, params GetItemsEventsParams)
+	// Export items as NDJSON
+	// (GET /items/export)
+	GetItemsExport(w http.ResponseWriter, r *http.Request, params GetItemsExportParams)
+	// Export items as CSV
+	// (GET /items/export.csv)
+	GetItemsExportCsv(w http.ResponseWriter, r *http.Request, params GetItemsExportCsvParams)
+	// Import items from NDJSON or CSV
+	// (POST /items/import)
+	PostItemsImport(w http.ResponseWriter, r *http.Request, params PostItemsImportParams)
 	// Get item by ID
 	// (GET /items/{id})
 	GetItemsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// Update item
 	// (PUT /items/{id})
 	PutItemsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get nested documents
+	// (GET /items/{id}/related)
+	GetItemsIdRelated(w http.ResponseWriter, r *http.Request, id UUIDPathParameter)
+	// Add nested document
+	// (POST /items/{id}/related)
+	PostItemsIdRelated(w http.ResponseWriter, r *http.Request, id UUIDPathParameter)
+	// Delete nested document
+	// (DELETE /items/{id}/related/{nestedId})
+	DeleteItemsIdRelatedNestedId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter)
+	// Get nested document
+	// (GET /items/{id}/related/{nestedId})
+	GetItemsIdRelatedNestedId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter)
+	// Update nested document
+	// (PUT /items/{id}/related/{nestedId})
+	PutItemsIdRelatedNestedId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter)
+	// Get atoms
+	// (GET /items/{id}/related/{nestedId}/related)
+	GetItemsIdRelatedNestedIdRelated(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter)
+	// Add atom
+	// (POST /items/{id}/related/{nestedId}/related)
+	PostItemsIdRelatedNestedIdRelated(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter)
+	// Delete atom
+	// (DELETE /items/{id}/related/{nestedId}/related/{atomId})
+	DeleteItemsIdRelatedNestedIdRelatedAtomId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter, atomId AtomIDPathParameter)
+	// Get atom
+	// (GET /items/{id}/related/{nestedId}/related/{atomId})
+	GetItemsIdRelatedNestedIdRelatedAtomId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter, atomId AtomIDPathParameter)
+	// Update atom
+	// (PUT /items/{id}/related/{nestedId}/related/{atomId})
+	PutItemsIdRelatedNestedIdRelatedAtomId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter, atomId AtomIDPathParameter)
 	// Live check
 	// (GET /live)
 	GetLive(w http.ResponseWriter, r *http.Request)
+	// Readiness check
+	// (GET /ready)
+	GetReady(w http.ResponseWriter, r *http.Request)
+	// Get items referencing a document
+	// (GET /related/{id}/items)
+	GetRelatedIdItems(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, params GetRelatedIdItemsParams)
+	// Get all webhooks
+	// (GET /webhooks)
+	GetWebhooks(w http.ResponseWriter, r *http.Request)
+	// Create a webhook
+	// (POST /webhooks)
+	PostWebhooks(w http.ResponseWriter, r *http.Request)
+	// Get dead letters
+	// (GET /webhooks/dead-letters)
+	GetWebhooksDeadLetters(w http.ResponseWriter, r *http.Request, params GetWebhooksDeadLettersParams)
+	// Retry a dead letter
+	// (POST /webhooks/dead-letters/{id}/retry)
+	PostWebhooksDeadLettersIdRetry(w http.ResponseWriter, r *http.Request, id UUIDPathParameter)
+	// Delete a webhook
+	// (DELETE /webhooks/{id})
+	DeleteWebhooksId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter)
+	// Get webhook by ID
+	// (GET /webhooks/{id})
+	GetWebhooksId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -167,33 +620,43 @@ func (siw *ServerInterfaceWrapper) GetItems(w http.ResponseWriter, r *http.Reque
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetItemsParams
 
-	// ------------- Required query parameter "limit" -------------
-
-	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+	// ------------- Optional query parameter "limit" -------------
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "offset" -------------
+	// ------------- Optional query parameter "ids" -------------
 
-	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", false, false, "ids", r.URL.Query(), &params.Ids)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ids", Err: err})
+		return
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
+	// ------------- Optional query parameter "related_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "related_id", r.URL.Query(), &params.RelatedId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "related_id", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "offset", r.URL.Query(), &params.Offset)
+	// ------------- Optional query parameter "atom_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "atom_id", r.URL.Query(), &params.AtomId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "atom_id", Err: err})
 		return
 	}
 
@@ -222,22 +685,46 @@ func (siw *ServerInterfaceWrapper) PostItems(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// GetItemsId operation middleware
-func (siw *ServerInterfaceWrapper) GetItemsId(w http.ResponseWriter, r *http.Request) {
+// PostItemsBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostItemsBatch(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostItemsBatch(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetItemsChanges operation middleware
+func (siw *ServerInterfaceWrapper) GetItemsChanges(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetItemsChangesParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetItemsId(w, r, id)
+		siw.Handler.GetItemsChanges(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -247,36 +734,53 @@ func (siw *ServerInterfaceWrapper) GetItemsId(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// PutItemsId operation middleware
-func (siw *ServerInterfaceWrapper) PutItemsId(w http.ResponseWriter, r *http.Request) {
+// GetItemsEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetItemsEvents(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetItemsEventsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "ids" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "ids", r.URL.Query(), &params.Ids)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ids", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutItemsId(w, r, id)
-	}))
+	// ------------- Optional query parameter "types" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", false, false, "types", r.URL.Query(), &params.Types)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "types", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	headers := r.Header
 
-// GetLive operation middleware
-func (siw *ServerInterfaceWrapper) GetLive(w http.ResponseWriter, r *http.Request) {
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID uint64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLive(w, r)
+		siw.Handler.GetItemsEvents(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -286,413 +790,3363 @@ func (siw *ServerInterfaceWrapper) GetLive(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// GetItemsExport operation middleware
+func (siw *ServerInterfaceWrapper) GetItemsExport(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetItemsExportParams
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Optional query parameter "related_id" -------------
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	err = runtime.BindQueryParameter("form", true, false, "related_id", r.URL.Query(), &params.RelatedId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "related_id", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Optional query parameter "atom_id" -------------
 
-type RequiredParamError struct {
-	ParamName string
-}
+	err = runtime.BindQueryParameter("form", true, false, "atom_id", r.URL.Query(), &params.AtomId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "atom_id", Err: err})
+		return
+	}
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	// ------------- Optional query parameter "mode" -------------
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindQueryParameter("form", true, false, "mode", r.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
+		return
+	}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	headers := r.Header
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Optional header parameter "Accept-Encoding" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Encoding")]; found {
+		var AcceptEncoding string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Accept-Encoding", Count: n})
+			return
+		}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept-Encoding", valueList[0], &AcceptEncoding, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Accept-Encoding", Err: err})
+			return
+		}
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+		params.AcceptEncoding = &AcceptEncoding
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	}
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetItemsExport(w, r, params)
+	}))
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
+	handler.ServeHTTP(w, r)
 }
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
-}
+// GetItemsExportCsv operation middleware
+func (siw *ServerInterfaceWrapper) GetItemsExportCsv(w http.ResponseWriter, r *http.Request) {
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var err error
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetItemsExportCsvParams
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
-}
+	// ------------- Optional query parameter "related_id" -------------
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+	err = runtime.BindQueryParameter("form", true, false, "related_id", r.URL.Query(), &params.RelatedId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "related_id", Err: err})
+		return
+	}
 
-	if m == nil {
-		m = http.NewServeMux()
+	// ------------- Optional query parameter "atom_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "atom_id", r.URL.Query(), &params.AtomId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "atom_id", Err: err})
+		return
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+
+	// ------------- Optional query parameter "layout" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "layout", r.URL.Query(), &params.Layout)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "layout", Err: err})
+		return
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	// ------------- Optional query parameter "columns" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "columns", r.URL.Query(), &params.Columns)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "columns", Err: err})
+		return
 	}
 
-	m.HandleFunc("GET "+options.BaseURL+"/health", wrapper.GetHealth)
-	m.HandleFunc("GET "+options.BaseURL+"/items", wrapper.GetItems)
-	m.HandleFunc("POST "+options.BaseURL+"/items", wrapper.PostItems)
-	m.HandleFunc("GET "+options.BaseURL+"/items/{id}", wrapper.GetItemsId)
-	m.HandleFunc("PUT "+options.BaseURL+"/items/{id}", wrapper.PutItemsId)
-	m.HandleFunc("GET "+options.BaseURL+"/live", wrapper.GetLive)
+	// ------------- Optional query parameter "bom" -------------
 
-	return m
+	err = runtime.BindQueryParameter("form", true, false, "bom", r.URL.Query(), &params.Bom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bom", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetItemsExportCsv(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type BadRequestJSONResponse ErrorResponse
+// PostItemsImport operation middleware
+func (siw *ServerInterfaceWrapper) PostItemsImport(w http.ResponseWriter, r *http.Request) {
 
-type InternalServerErrorJSONResponse ErrorResponse
+	var err error
 
-type NotFoundJSONResponse ErrorResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostItemsImportParams
 
-type GetHealthRequestObject struct {
-}
+	// ------------- Optional query parameter "format" -------------
 
-type GetHealthResponseObject interface {
-	VisitGetHealthResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
 
-type GetHealth200JSONResponse struct {
-	Status  *string `json:"status,omitempty"`
-	Version *string `json:"version,omitempty"`
-}
+	// ------------- Optional query parameter "policy" -------------
 
-func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "policy", r.URL.Query(), &params.Policy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policy", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "dry_run" -------------
 
-type GetHealth503JSONResponse struct {
-	Error  *string `json:"error,omitempty"`
-	Status *string `json:"status,omitempty"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
 
-func (response GetHealth503JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(503)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostItemsImport(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetItemsRequestObject struct {
-	Params GetItemsParams
+	handler.ServeHTTP(w, r)
 }
 
-type GetItemsResponseObject interface {
-	VisitGetItemsResponse(w http.ResponseWriter) error
-}
+// GetItemsId operation middleware
+func (siw *ServerInterfaceWrapper) GetItemsId(w http.ResponseWriter, r *http.Request) {
 
-type GetItems200JSONResponse struct {
-	Items []Item `json:"items"`
-	Total *int   `json:"total,omitempty"`
-}
+	var err error
 
-func (response GetItems200JSONResponse) VisitGetItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetItems500JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetItemsId(w, r, id)
+	}))
 
-func (response GetItems500JSONResponse) VisitGetItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostItemsRequestObject struct {
-	Body *PostItemsJSONRequestBody
-}
+// PutItemsId operation middleware
+func (siw *ServerInterfaceWrapper) PutItemsId(w http.ResponseWriter, r *http.Request) {
 
-type PostItemsResponseObject interface {
-	VisitPostItemsResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type PostItems200JSONResponse Item
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-func (response PostItems200JSONResponse) VisitPostItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutItemsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostItems400JSONResponse ErrorResponse
+// GetItemsIdRelated operation middleware
+func (siw *ServerInterfaceWrapper) GetItemsIdRelated(w http.ResponseWriter, r *http.Request) {
 
-func (response PostItems400JSONResponse) VisitPostItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id UUIDPathParameter
 
-type PostItems500JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response PostItems500JSONResponse) VisitPostItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetItemsIdRelated(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetItemsIdRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+// PostItemsIdRelated operation middleware
+func (siw *ServerInterfaceWrapper) PostItemsIdRelated(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UUIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostItemsIdRelated(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetItemsIdResponseObject interface {
-	VisitGetItemsIdResponse(w http.ResponseWriter) error
+// DeleteItemsIdRelatedNestedId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteItemsIdRelatedNestedId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UUIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "nestedId" -------------
+	var nestedId NestedIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nestedId", r.PathValue("nestedId"), &nestedId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nestedId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteItemsIdRelatedNestedId(w, r, id, nestedId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetItemsId200JSONResponse Item
+// GetItemsIdRelatedNestedId operation middleware
+func (siw *ServerInterfaceWrapper) GetItemsIdRelatedNestedId(w http.ResponseWriter, r *http.Request) {
 
-func (response GetItemsId200JSONResponse) VisitGetItemsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// ------------- Path parameter "id" -------------
+	var id UUIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "nestedId" -------------
+	var nestedId NestedIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nestedId", r.PathValue("nestedId"), &nestedId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nestedId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetItemsIdRelatedNestedId(w, r, id, nestedId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutItemsIdRelatedNestedId operation middleware
+func (siw *ServerInterfaceWrapper) PutItemsIdRelatedNestedId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UUIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "nestedId" -------------
+	var nestedId NestedIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nestedId", r.PathValue("nestedId"), &nestedId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nestedId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutItemsIdRelatedNestedId(w, r, id, nestedId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetItemsIdRelatedNestedIdRelated operation middleware
+func (siw *ServerInterfaceWrapper) GetItemsIdRelatedNestedIdRelated(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UUIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "nestedId" -------------
+	var nestedId NestedIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nestedId", r.PathValue("nestedId"), &nestedId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nestedId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetItemsIdRelatedNestedIdRelated(w, r, id, nestedId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostItemsIdRelatedNestedIdRelated operation middleware
+func (siw *ServerInterfaceWrapper) PostItemsIdRelatedNestedIdRelated(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UUIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "nestedId" -------------
+	var nestedId NestedIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nestedId", r.PathValue("nestedId"), &nestedId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nestedId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostItemsIdRelatedNestedIdRelated(w, r, id, nestedId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteItemsIdRelatedNestedIdRelatedAtomId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteItemsIdRelatedNestedIdRelatedAtomId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UUIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "nestedId" -------------
+	var nestedId NestedIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nestedId", r.PathValue("nestedId"), &nestedId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nestedId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "atomId" -------------
+	var atomId AtomIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "atomId", r.PathValue("atomId"), &atomId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "atomId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteItemsIdRelatedNestedIdRelatedAtomId(w, r, id, nestedId, atomId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetItemsIdRelatedNestedIdRelatedAtomId operation middleware
+func (siw *ServerInterfaceWrapper) GetItemsIdRelatedNestedIdRelatedAtomId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UUIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "nestedId" -------------
+	var nestedId NestedIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nestedId", r.PathValue("nestedId"), &nestedId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nestedId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "atomId" -------------
+	var atomId AtomIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "atomId", r.PathValue("atomId"), &atomId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "atomId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetItemsIdRelatedNestedIdRelatedAtomId(w, r, id, nestedId, atomId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutItemsIdRelatedNestedIdRelatedAtomId operation middleware
+func (siw *ServerInterfaceWrapper) PutItemsIdRelatedNestedIdRelatedAtomId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UUIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "nestedId" -------------
+	var nestedId NestedIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nestedId", r.PathValue("nestedId"), &nestedId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nestedId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "atomId" -------------
+	var atomId AtomIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "atomId", r.PathValue("atomId"), &atomId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "atomId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutItemsIdRelatedNestedIdRelatedAtomId(w, r, id, nestedId, atomId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLive operation middleware
+func (siw *ServerInterfaceWrapper) GetLive(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReady operation middleware
+func (siw *ServerInterfaceWrapper) GetReady(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReady(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRelatedIdItems operation middleware
+func (siw *ServerInterfaceWrapper) GetRelatedIdItems(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UUIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRelatedIdItemsParams
+
+	// ------------- Required query parameter "limit" -------------
+
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "offset" -------------
+
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRelatedIdItems(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhooks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooksDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksDeadLettersParams
+
+	// ------------- Required query parameter "limit" -------------
+
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "offset" -------------
+
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooksDeadLetters(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhooksDeadLettersIdRetry operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksDeadLettersIdRetry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UUIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhooksDeadLettersIdRetry(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhooksId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhooksId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UUIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhooksId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooksId operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UUIDPathParameter
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooksId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/health", wrapper.GetHealth)
+	m.HandleFunc("GET "+options.BaseURL+"/items", wrapper.GetItems)
+	m.HandleFunc("POST "+options.BaseURL+"/items", wrapper.PostItems)
+	m.HandleFunc("POST "+options.BaseURL+"/items/batch", wrapper.PostItemsBatch)
+	m.HandleFunc("GET "+options.BaseURL+"/items/changes", wrapper.GetItemsChanges)
+	m.HandleFunc("GET "+options.BaseURL+"/items/events", wrapper.GetItemsEvents)
+	m.HandleFunc("GET "+options.BaseURL+"/items/export", wrapper.GetItemsExport)
+	m.HandleFunc("GET "+options.BaseURL+"/items/export.csv", wrapper.GetItemsExportCsv)
+	m.HandleFunc("POST "+options.BaseURL+"/items/import", wrapper.PostItemsImport)
+	m.HandleFunc("GET "+options.BaseURL+"/items/{id}", wrapper.GetItemsId)
+	m.HandleFunc("PUT "+options.BaseURL+"/items/{id}", wrapper.PutItemsId)
+	m.HandleFunc("GET "+options.BaseURL+"/items/{id}/related", wrapper.GetItemsIdRelated)
+	m.HandleFunc("POST "+options.BaseURL+"/items/{id}/related", wrapper.PostItemsIdRelated)
+	m.HandleFunc("DELETE "+options.BaseURL+"/items/{id}/related/{nestedId}", wrapper.DeleteItemsIdRelatedNestedId)
+	m.HandleFunc("GET "+options.BaseURL+"/items/{id}/related/{nestedId}", wrapper.GetItemsIdRelatedNestedId)
+	m.HandleFunc("PUT "+options.BaseURL+"/items/{id}/related/{nestedId}", wrapper.PutItemsIdRelatedNestedId)
+	m.HandleFunc("GET "+options.BaseURL+"/items/{id}/related/{nestedId}/related", wrapper.GetItemsIdRelatedNestedIdRelated)
+	m.HandleFunc("POST "+options.BaseURL+"/items/{id}/related/{nestedId}/related", wrapper.PostItemsIdRelatedNestedIdRelated)
+	m.HandleFunc("DELETE "+options.BaseURL+"/items/{id}/related/{nestedId}/related/{atomId}", wrapper.DeleteItemsIdRelatedNestedIdRelatedAtomId)
+	m.HandleFunc("GET "+options.BaseURL+"/items/{id}/related/{nestedId}/related/{atomId}", wrapper.GetItemsIdRelatedNestedIdRelatedAtomId)
+	m.HandleFunc("PUT "+options.BaseURL+"/items/{id}/related/{nestedId}/related/{atomId}", wrapper.PutItemsIdRelatedNestedIdRelatedAtomId)
+	m.HandleFunc("GET "+options.BaseURL+"/live", wrapper.GetLive)
+	m.HandleFunc("GET "+options.BaseURL+"/ready", wrapper.GetReady)
+	m.HandleFunc("GET "+options.BaseURL+"/related/{id}/items", wrapper.GetRelatedIdItems)
+	m.HandleFunc("GET "+options.BaseURL+"/webhooks", wrapper.GetWebhooks)
+	m.HandleFunc("POST "+options.BaseURL+"/webhooks", wrapper.PostWebhooks)
+	m.HandleFunc("GET "+options.BaseURL+"/webhooks/dead-letters", wrapper.GetWebhooksDeadLetters)
+	m.HandleFunc("POST "+options.BaseURL+"/webhooks/dead-letters/{id}/retry", wrapper.PostWebhooksDeadLettersIdRetry)
+	m.HandleFunc("DELETE "+options.BaseURL+"/webhooks/{id}", wrapper.DeleteWebhooksId)
+	m.HandleFunc("GET "+options.BaseURL+"/webhooks/{id}", wrapper.GetWebhooksId)
+
+	return m
+}
+
+type BadRequestApplicationProblemPlusJSONResponse Problem
+
+type ConflictApplicationProblemPlusJSONResponse Problem
+
+type GoneApplicationProblemPlusJSONResponse Problem
+
+type InternalServerErrorApplicationProblemPlusJSONResponse Problem
+
+type NotFoundApplicationProblemPlusJSONResponse Problem
+
+type PreconditionFailedApplicationProblemPlusJSONResponse Problem
+
+type ServiceUnavailableApplicationProblemPlusJSONResponse Problem
+
+type GetHealthRequestObject struct {
+}
+
+type GetHealthResponseObject interface {
+	VisitGetHealthResponse(w http.ResponseWriter) error
+}
+
+type GetHealth200JSONResponse HealthReport
+
+func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealth503JSONResponse HealthReport
+
+func (response GetHealth503JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsRequestObject struct {
+	Params GetItemsParams
+}
+
+type GetItemsResponseObject interface {
+	VisitGetItemsResponse(w http.ResponseWriter) error
+}
+
+type GetItems200JSONResponse ItemList
+
+func (response GetItems200JSONResponse) VisitGetItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItems400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetItems400ApplicationProblemPlusJSONResponse) VisitGetItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItems500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetItems500ApplicationProblemPlusJSONResponse) VisitGetItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItems503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response GetItems503ApplicationProblemPlusJSONResponse) VisitGetItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsRequestObject struct {
+	Body *PostItemsJSONRequestBody
+}
+
+type PostItemsResponseObject interface {
+	VisitPostItemsResponse(w http.ResponseWriter) error
+}
+
+type PostItems200JSONResponse Item
+
+func (response PostItems200JSONResponse) VisitPostItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItems400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostItems400ApplicationProblemPlusJSONResponse) VisitPostItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItems409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PostItems409ApplicationProblemPlusJSONResponse) VisitPostItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItems500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostItems500ApplicationProblemPlusJSONResponse) VisitPostItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItems503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response PostItems503ApplicationProblemPlusJSONResponse) VisitPostItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsBatchRequestObject struct {
+	Body *PostItemsBatchJSONRequestBody
+}
+
+type PostItemsBatchResponseObject interface {
+	VisitPostItemsBatchResponse(w http.ResponseWriter) error
+}
+
+type PostItemsBatch200JSONResponse ItemBatch
+
+func (response PostItemsBatch200JSONResponse) VisitPostItemsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsBatch400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostItemsBatch400ApplicationProblemPlusJSONResponse) VisitPostItemsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsBatch500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostItemsBatch500ApplicationProblemPlusJSONResponse) VisitPostItemsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsBatch503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response PostItemsBatch503ApplicationProblemPlusJSONResponse) VisitPostItemsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsChangesRequestObject struct {
+	Params GetItemsChangesParams
+}
+
+type GetItemsChangesResponseObject interface {
+	VisitGetItemsChangesResponse(w http.ResponseWriter) error
+}
+
+type GetItemsChanges200JSONResponse ChangeSet
+
+func (response GetItemsChanges200JSONResponse) VisitGetItemsChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsChanges400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsChanges400ApplicationProblemPlusJSONResponse) VisitGetItemsChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsChanges410ApplicationProblemPlusJSONResponse struct {
+	GoneApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsChanges410ApplicationProblemPlusJSONResponse) VisitGetItemsChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsChanges500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsChanges500ApplicationProblemPlusJSONResponse) VisitGetItemsChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsChanges503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsChanges503ApplicationProblemPlusJSONResponse) VisitGetItemsChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsEventsRequestObject struct {
+	Params GetItemsEventsParams
+}
+
+type GetItemsEventsResponseObject interface {
+	VisitGetItemsEventsResponse(w http.ResponseWriter) error
+}
+
+type GetItemsEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetItemsEvents200TexteventStreamResponse) VisitGetItemsEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetItemsEvents400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsEvents400ApplicationProblemPlusJSONResponse) VisitGetItemsEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsExportRequestObject struct {
+	Params GetItemsExportParams
+}
+
+type GetItemsExportResponseObject interface {
+	VisitGetItemsExportResponse(w http.ResponseWriter) error
+}
+
+type GetItemsExport200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetItemsExport200ApplicationxNdjsonResponse) VisitGetItemsExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetItemsExport400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsExport400ApplicationProblemPlusJSONResponse) VisitGetItemsExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsExportCsvRequestObject struct {
+	Params GetItemsExportCsvParams
+}
+
+type GetItemsExportCsvResponseObject interface {
+	VisitGetItemsExportCsvResponse(w http.ResponseWriter) error
+}
+
+type GetItemsExportCsv200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetItemsExportCsv200TextcsvResponse) VisitGetItemsExportCsvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetItemsExportCsv400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsExportCsv400ApplicationProblemPlusJSONResponse) VisitGetItemsExportCsvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsImportRequestObject struct {
+	Params      PostItemsImportParams
+	ContentType string
+	Body        io.Reader
+}
+
+type PostItemsImportResponseObject interface {
+	VisitPostItemsImportResponse(w http.ResponseWriter) error
+}
+
+type PostItemsImport200JSONResponse ImportReport
+
+func (response PostItemsImport200JSONResponse) VisitPostItemsImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsImport400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostItemsImport400ApplicationProblemPlusJSONResponse) VisitPostItemsImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsImport503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response PostItemsImport503ApplicationProblemPlusJSONResponse) VisitPostItemsImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetItemsIdResponseObject interface {
+	VisitGetItemsIdResponse(w http.ResponseWriter) error
+}
+
+type GetItemsId200JSONResponse Item
+
+func (response GetItemsId200JSONResponse) VisitGetItemsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsId400ApplicationProblemPlusJSONResponse) VisitGetItemsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsId404ApplicationProblemPlusJSONResponse) VisitGetItemsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsId500ApplicationProblemPlusJSONResponse) VisitGetItemsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsId503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsId503ApplicationProblemPlusJSONResponse) VisitGetItemsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsIdRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *PutItemsIdJSONRequestBody
+}
+
+type PutItemsIdResponseObject interface {
+	VisitPutItemsIdResponse(w http.ResponseWriter) error
+}
+
+type PutItemsId200JSONResponse Item
+
+func (response PutItemsId200JSONResponse) VisitPutItemsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PutItemsId400ApplicationProblemPlusJSONResponse) VisitPutItemsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PutItemsId404ApplicationProblemPlusJSONResponse) VisitPutItemsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PutItemsId500ApplicationProblemPlusJSONResponse) VisitPutItemsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsId503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response PutItemsId503ApplicationProblemPlusJSONResponse) VisitPutItemsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedRequestObject struct {
+	Id UUIDPathParameter `json:"id"`
+}
+
+type GetItemsIdRelatedResponseObject interface {
+	VisitGetItemsIdRelatedResponse(w http.ResponseWriter) error
+}
+
+type GetItemsIdRelated200JSONResponse []Nested
+
+func (response GetItemsIdRelated200JSONResponse) VisitGetItemsIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelated400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelated400ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelated404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelated404ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelated500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelated500ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelated503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelated503ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsIdRelatedRequestObject struct {
+	Id   UUIDPathParameter `json:"id"`
+	Body *PostItemsIdRelatedJSONRequestBody
+}
+
+type PostItemsIdRelatedResponseObject interface {
+	VisitPostItemsIdRelatedResponse(w http.ResponseWriter) error
+}
+
+type PostItemsIdRelated200JSONResponse Nested
+
+func (response PostItemsIdRelated200JSONResponse) VisitPostItemsIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsIdRelated400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostItemsIdRelated400ApplicationProblemPlusJSONResponse) VisitPostItemsIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsIdRelated404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PostItemsIdRelated404ApplicationProblemPlusJSONResponse) VisitPostItemsIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsIdRelated409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PostItemsIdRelated409ApplicationProblemPlusJSONResponse) VisitPostItemsIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsIdRelated500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostItemsIdRelated500ApplicationProblemPlusJSONResponse) VisitPostItemsIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsIdRelated503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response PostItemsIdRelated503ApplicationProblemPlusJSONResponse) VisitPostItemsIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemsIdRelatedNestedIdRequestObject struct {
+	Id       UUIDPathParameter     `json:"id"`
+	NestedId NestedIDPathParameter `json:"nestedId"`
+}
+
+type DeleteItemsIdRelatedNestedIdResponseObject interface {
+	VisitDeleteItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteItemsIdRelatedNestedId204Response struct {
+}
+
+func (response DeleteItemsIdRelatedNestedId204Response) VisitDeleteItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteItemsIdRelatedNestedId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemsIdRelatedNestedId400ApplicationProblemPlusJSONResponse) VisitDeleteItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemsIdRelatedNestedId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemsIdRelatedNestedId404ApplicationProblemPlusJSONResponse) VisitDeleteItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemsIdRelatedNestedId409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemsIdRelatedNestedId409ApplicationProblemPlusJSONResponse) VisitDeleteItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemsIdRelatedNestedId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemsIdRelatedNestedId500ApplicationProblemPlusJSONResponse) VisitDeleteItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemsIdRelatedNestedId503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemsIdRelatedNestedId503ApplicationProblemPlusJSONResponse) VisitDeleteItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedNestedIdRequestObject struct {
+	Id       UUIDPathParameter     `json:"id"`
+	NestedId NestedIDPathParameter `json:"nestedId"`
+}
+
+type GetItemsIdRelatedNestedIdResponseObject interface {
+	VisitGetItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error
+}
+
+type GetItemsIdRelatedNestedId200JSONResponse Nested
+
+func (response GetItemsIdRelatedNestedId200JSONResponse) VisitGetItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedNestedId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelatedNestedId400ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedNestedId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelatedNestedId404ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedNestedId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelatedNestedId500ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedNestedId503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelatedNestedId503ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsIdRelatedNestedIdRequestObject struct {
+	Id       UUIDPathParameter     `json:"id"`
+	NestedId NestedIDPathParameter `json:"nestedId"`
+	Body     *PutItemsIdRelatedNestedIdJSONRequestBody
+}
+
+type PutItemsIdRelatedNestedIdResponseObject interface {
+	VisitPutItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error
+}
+
+type PutItemsIdRelatedNestedId200JSONResponse Nested
+
+func (response PutItemsIdRelatedNestedId200JSONResponse) VisitPutItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsIdRelatedNestedId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PutItemsIdRelatedNestedId400ApplicationProblemPlusJSONResponse) VisitPutItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsIdRelatedNestedId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PutItemsIdRelatedNestedId404ApplicationProblemPlusJSONResponse) VisitPutItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsIdRelatedNestedId409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PutItemsIdRelatedNestedId409ApplicationProblemPlusJSONResponse) VisitPutItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsIdRelatedNestedId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PutItemsIdRelatedNestedId500ApplicationProblemPlusJSONResponse) VisitPutItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsIdRelatedNestedId503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response PutItemsIdRelatedNestedId503ApplicationProblemPlusJSONResponse) VisitPutItemsIdRelatedNestedIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedNestedIdRelatedRequestObject struct {
+	Id       UUIDPathParameter     `json:"id"`
+	NestedId NestedIDPathParameter `json:"nestedId"`
+}
+
+type GetItemsIdRelatedNestedIdRelatedResponseObject interface {
+	VisitGetItemsIdRelatedNestedIdRelatedResponse(w http.ResponseWriter) error
+}
+
+type GetItemsIdRelatedNestedIdRelated200JSONResponse []Atom
+
+func (response GetItemsIdRelatedNestedIdRelated200JSONResponse) VisitGetItemsIdRelatedNestedIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedNestedIdRelated400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelatedNestedIdRelated400ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedNestedIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedNestedIdRelated404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelatedNestedIdRelated404ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedNestedIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedNestedIdRelated500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelatedNestedIdRelated500ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedNestedIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedNestedIdRelated503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelatedNestedIdRelated503ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedNestedIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsIdRelatedNestedIdRelatedRequestObject struct {
+	Id       UUIDPathParameter     `json:"id"`
+	NestedId NestedIDPathParameter `json:"nestedId"`
+	Body     *PostItemsIdRelatedNestedIdRelatedJSONRequestBody
+}
+
+type PostItemsIdRelatedNestedIdRelatedResponseObject interface {
+	VisitPostItemsIdRelatedNestedIdRelatedResponse(w http.ResponseWriter) error
+}
+
+type PostItemsIdRelatedNestedIdRelated200JSONResponse Atom
+
+func (response PostItemsIdRelatedNestedIdRelated200JSONResponse) VisitPostItemsIdRelatedNestedIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsIdRelatedNestedIdRelated400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostItemsIdRelatedNestedIdRelated400ApplicationProblemPlusJSONResponse) VisitPostItemsIdRelatedNestedIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsIdRelatedNestedIdRelated404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PostItemsIdRelatedNestedIdRelated404ApplicationProblemPlusJSONResponse) VisitPostItemsIdRelatedNestedIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsIdRelatedNestedIdRelated409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PostItemsIdRelatedNestedIdRelated409ApplicationProblemPlusJSONResponse) VisitPostItemsIdRelatedNestedIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsIdRelatedNestedIdRelated500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostItemsIdRelatedNestedIdRelated500ApplicationProblemPlusJSONResponse) VisitPostItemsIdRelatedNestedIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostItemsIdRelatedNestedIdRelated503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response PostItemsIdRelatedNestedIdRelated503ApplicationProblemPlusJSONResponse) VisitPostItemsIdRelatedNestedIdRelatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemsIdRelatedNestedIdRelatedAtomIdRequestObject struct {
+	Id       UUIDPathParameter     `json:"id"`
+	NestedId NestedIDPathParameter `json:"nestedId"`
+	AtomId   AtomIDPathParameter   `json:"atomId"`
+}
+
+type DeleteItemsIdRelatedNestedIdRelatedAtomIdResponseObject interface {
+	VisitDeleteItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteItemsIdRelatedNestedIdRelatedAtomId204Response struct {
+}
+
+func (response DeleteItemsIdRelatedNestedIdRelatedAtomId204Response) VisitDeleteItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteItemsIdRelatedNestedIdRelatedAtomId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemsIdRelatedNestedIdRelatedAtomId400ApplicationProblemPlusJSONResponse) VisitDeleteItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemsIdRelatedNestedIdRelatedAtomId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemsIdRelatedNestedIdRelatedAtomId404ApplicationProblemPlusJSONResponse) VisitDeleteItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemsIdRelatedNestedIdRelatedAtomId409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemsIdRelatedNestedIdRelatedAtomId409ApplicationProblemPlusJSONResponse) VisitDeleteItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemsIdRelatedNestedIdRelatedAtomId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemsIdRelatedNestedIdRelatedAtomId500ApplicationProblemPlusJSONResponse) VisitDeleteItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemsIdRelatedNestedIdRelatedAtomId503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemsIdRelatedNestedIdRelatedAtomId503ApplicationProblemPlusJSONResponse) VisitDeleteItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedNestedIdRelatedAtomIdRequestObject struct {
+	Id       UUIDPathParameter     `json:"id"`
+	NestedId NestedIDPathParameter `json:"nestedId"`
+	AtomId   AtomIDPathParameter   `json:"atomId"`
+}
+
+type GetItemsIdRelatedNestedIdRelatedAtomIdResponseObject interface {
+	VisitGetItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error
+}
+
+type GetItemsIdRelatedNestedIdRelatedAtomId200JSONResponse Atom
+
+func (response GetItemsIdRelatedNestedIdRelatedAtomId200JSONResponse) VisitGetItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedNestedIdRelatedAtomId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelatedNestedIdRelatedAtomId400ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedNestedIdRelatedAtomId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelatedNestedIdRelatedAtomId404ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedNestedIdRelatedAtomId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelatedNestedIdRelatedAtomId500ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetItemsIdRelatedNestedIdRelatedAtomId503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response GetItemsIdRelatedNestedIdRelatedAtomId503ApplicationProblemPlusJSONResponse) VisitGetItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsIdRelatedNestedIdRelatedAtomIdRequestObject struct {
+	Id       UUIDPathParameter     `json:"id"`
+	NestedId NestedIDPathParameter `json:"nestedId"`
+	AtomId   AtomIDPathParameter   `json:"atomId"`
+	Body     *PutItemsIdRelatedNestedIdRelatedAtomIdJSONRequestBody
+}
+
+type PutItemsIdRelatedNestedIdRelatedAtomIdResponseObject interface {
+	VisitPutItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error
+}
+
+type PutItemsIdRelatedNestedIdRelatedAtomId200JSONResponse Atom
+
+func (response PutItemsIdRelatedNestedIdRelatedAtomId200JSONResponse) VisitPutItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsIdRelatedNestedIdRelatedAtomId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PutItemsIdRelatedNestedIdRelatedAtomId400ApplicationProblemPlusJSONResponse) VisitPutItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsIdRelatedNestedIdRelatedAtomId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PutItemsIdRelatedNestedIdRelatedAtomId404ApplicationProblemPlusJSONResponse) VisitPutItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsIdRelatedNestedIdRelatedAtomId409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PutItemsIdRelatedNestedIdRelatedAtomId409ApplicationProblemPlusJSONResponse) VisitPutItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsIdRelatedNestedIdRelatedAtomId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PutItemsIdRelatedNestedIdRelatedAtomId500ApplicationProblemPlusJSONResponse) VisitPutItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutItemsIdRelatedNestedIdRelatedAtomId503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response PutItemsIdRelatedNestedIdRelatedAtomId503ApplicationProblemPlusJSONResponse) VisitPutItemsIdRelatedNestedIdRelatedAtomIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLiveRequestObject struct {
+}
+
+type GetLiveResponseObject interface {
+	VisitGetLiveResponse(w http.ResponseWriter) error
+}
+
+type GetLive200JSONResponse Liveness
+
+func (response GetLive200JSONResponse) VisitGetLiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReadyRequestObject struct {
+}
+
+type GetReadyResponseObject interface {
+	VisitGetReadyResponse(w http.ResponseWriter) error
+}
+
+type GetReady200JSONResponse HealthReport
+
+func (response GetReady200JSONResponse) VisitGetReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReady503JSONResponse HealthReport
+
+func (response GetReady503JSONResponse) VisitGetReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRelatedIdItemsRequestObject struct {
+	Id     UUIDPathParameter `json:"id"`
+	Params GetRelatedIdItemsParams
+}
+
+type GetRelatedIdItemsResponseObject interface {
+	VisitGetRelatedIdItemsResponse(w http.ResponseWriter) error
+}
+
+type GetRelatedIdItems200JSONResponse ItemList
+
+func (response GetRelatedIdItems200JSONResponse) VisitGetRelatedIdItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRelatedIdItems400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetRelatedIdItems400ApplicationProblemPlusJSONResponse) VisitGetRelatedIdItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRelatedIdItems500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetRelatedIdItems500ApplicationProblemPlusJSONResponse) VisitGetRelatedIdItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRelatedIdItems503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response GetRelatedIdItems503ApplicationProblemPlusJSONResponse) VisitGetRelatedIdItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksRequestObject struct {
+}
+
+type GetWebhooksResponseObject interface {
+	VisitGetWebhooksResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooks200JSONResponse WebhookList
+
+func (response GetWebhooks200JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhooks500ApplicationProblemPlusJSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhooks503ApplicationProblemPlusJSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksRequestObject struct {
+	Body *PostWebhooksJSONRequestBody
+}
+
+type PostWebhooksResponseObject interface {
+	VisitPostWebhooksResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooks200JSONResponse Webhook
+
+func (response PostWebhooks200JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooks400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostWebhooks400ApplicationProblemPlusJSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooks500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostWebhooks500ApplicationProblemPlusJSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooks503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response PostWebhooks503ApplicationProblemPlusJSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksDeadLettersRequestObject struct {
+	Params GetWebhooksDeadLettersParams
+}
+
+type GetWebhooksDeadLettersResponseObject interface {
+	VisitGetWebhooksDeadLettersResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooksDeadLetters200JSONResponse DeliveryList
+
+func (response GetWebhooksDeadLetters200JSONResponse) VisitGetWebhooksDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksDeadLetters400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhooksDeadLetters400ApplicationProblemPlusJSONResponse) VisitGetWebhooksDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksDeadLetters500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhooksDeadLetters500ApplicationProblemPlusJSONResponse) VisitGetWebhooksDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksDeadLetters503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhooksDeadLetters503ApplicationProblemPlusJSONResponse) VisitGetWebhooksDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksDeadLettersIdRetryRequestObject struct {
+	Id UUIDPathParameter `json:"id"`
+}
+
+type PostWebhooksDeadLettersIdRetryResponseObject interface {
+	VisitPostWebhooksDeadLettersIdRetryResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksDeadLettersIdRetry204Response struct {
+}
+
+func (response PostWebhooksDeadLettersIdRetry204Response) VisitPostWebhooksDeadLettersIdRetryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostWebhooksDeadLettersIdRetry404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PostWebhooksDeadLettersIdRetry404ApplicationProblemPlusJSONResponse) VisitPostWebhooksDeadLettersIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksDeadLettersIdRetry500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostWebhooksDeadLettersIdRetry500ApplicationProblemPlusJSONResponse) VisitPostWebhooksDeadLettersIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksDeadLettersIdRetry503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response PostWebhooksDeadLettersIdRetry503ApplicationProblemPlusJSONResponse) VisitPostWebhooksDeadLettersIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhooksIdRequestObject struct {
+	Id UUIDPathParameter `json:"id"`
+}
+
+type DeleteWebhooksIdResponseObject interface {
+	VisitDeleteWebhooksIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhooksId204Response struct {
+}
+
+func (response DeleteWebhooksId204Response) VisitDeleteWebhooksIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhooksId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhooksId404ApplicationProblemPlusJSONResponse) VisitDeleteWebhooksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhooksId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhooksId500ApplicationProblemPlusJSONResponse) VisitDeleteWebhooksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhooksId503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhooksId503ApplicationProblemPlusJSONResponse) VisitDeleteWebhooksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksIdRequestObject struct {
+	Id UUIDPathParameter `json:"id"`
+}
+
+type GetWebhooksIdResponseObject interface {
+	VisitGetWebhooksIdResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooksId200JSONResponse Webhook
+
+func (response GetWebhooksId200JSONResponse) VisitGetWebhooksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhooksId404ApplicationProblemPlusJSONResponse) VisitGetWebhooksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhooksId500ApplicationProblemPlusJSONResponse) VisitGetWebhooksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksId503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response GetWebhooksId503ApplicationProblemPlusJSONResponse) VisitGetWebhooksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Health check
+	// (GET /health)
+	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
+	// Get all items
+	// (GET /items)
+	GetItems(ctx context.Context, request GetItemsRequestObject) (GetItemsResponseObject, error)
+	// Create a new item
+	// (POST /items)
+	PostItems(ctx context.Context, request PostItemsRequestObject) (PostItemsResponseObject, error)
+	// Get items by UUIDs
+	// (POST /items/batch)
+	PostItemsBatch(ctx context.Context, request PostItemsBatchRequestObject) (PostItemsBatchResponseObject, error)
+	// Get changes since the last sync
+	// (GET /items/changes)
+	GetItemsChanges(ctx context.Context, request GetItemsChangesRequestObject) (GetItemsChangesResponseObject, error)
+	// Item change feed
+	// (GET /items/events)
+	GetItemsEvents(ctx context.Context, request GetItemsEventsRequestObject) (GetItemsEventsResponseObject, error)
+	// Export items as NDJSON
+	// (GET /items/export)
+	GetItemsExport(ctx context.Context, request GetItemsExportRequestObject) (GetItemsExportResponseObject, error)
+	// Export items as CSV
+	// (GET /items/export.csv)
+	GetItemsExportCsv(ctx context.Context, request GetItemsExportCsvRequestObject) (GetItemsExportCsvResponseObject, error)
+	// Import items from NDJSON or CSV
+	// (POST /items/import)
+	PostItemsImport(ctx context.Context, request PostItemsImportRequestObject) (PostItemsImportResponseObject, error)
+	// Get item by ID
+	// (GET /items/{id})
+	GetItemsId(ctx context.Context, request GetItemsIdRequestObject) (GetItemsIdResponseObject, error)
+	// Update item
+	// (PUT /items/{id})
+	PutItemsId(ctx context.Context, request PutItemsIdRequestObject) (PutItemsIdResponseObject, error)
+	// Get nested documents
+	// (GET /items/{id}/related)
+	GetItemsIdRelated(ctx context.Context, request GetItemsIdRelatedRequestObject) (GetItemsIdRelatedResponseObject, error)
+	// Add nested document
+	// (POST /items/{id}/related)
+	PostItemsIdRelated(ctx context.Context, request PostItemsIdRelatedRequestObject) (PostItemsIdRelatedResponseObject, error)
+	// Delete nested document
+	// (DELETE /items/{id}/related/{nestedId})
+	DeleteItemsIdRelatedNestedId(ctx context.Context, request DeleteItemsIdRelatedNestedIdRequestObject) (DeleteItemsIdRelatedNestedIdResponseObject, error)
+	// Get nested document
+	// (GET /items/{id}/related/{nestedId})
+	GetItemsIdRelatedNestedId(ctx context.Context, request GetItemsIdRelatedNestedIdRequestObject) (GetItemsIdRelatedNestedIdResponseObject, error)
+	// Update nested document
+	// (PUT /items/{id}/related/{nestedId})
+	PutItemsIdRelatedNestedId(ctx context.Context, request PutItemsIdRelatedNestedIdRequestObject) (PutItemsIdRelatedNestedIdResponseObject, error)
+	// Get atoms
+	// (GET /items/{id}/related/{nestedId}/related)
+	GetItemsIdRelatedNestedIdRelated(ctx context.Context, request GetItemsIdRelatedNestedIdRelatedRequestObject) (GetItemsIdRelatedNestedIdRelatedResponseObject, error)
+	// Add atom
+	// (POST /items/{id}/related/{nestedId}/related)
+	PostItemsIdRelatedNestedIdRelated(ctx context.Context, request PostItemsIdRelatedNestedIdRelatedRequestObject) (PostItemsIdRelatedNestedIdRelatedResponseObject, error)
+	// Delete atom
+	// (DELETE /items/{id}/related/{nestedId}/related/{atomId})
+	DeleteItemsIdRelatedNestedIdRelatedAtomId(ctx context.Context, request DeleteItemsIdRelatedNestedIdRelatedAtomIdRequestObject) (DeleteItemsIdRelatedNestedIdRelatedAtomIdResponseObject, error)
+	// Get atom
+	// (GET /items/{id}/related/{nestedId}/related/{atomId})
+	GetItemsIdRelatedNestedIdRelatedAtomId(ctx context.Context, request GetItemsIdRelatedNestedIdRelatedAtomIdRequestObject) (GetItemsIdRelatedNestedIdRelatedAtomIdResponseObject, error)
+	// Update atom
+	// (PUT /items/{id}/related/{nestedId}/related/{atomId})
+	PutItemsIdRelatedNestedIdRelatedAtomId(ctx context.Context, request PutItemsIdRelatedNestedIdRelatedAtomIdRequestObject) (PutItemsIdRelatedNestedIdRelatedAtomIdResponseObject, error)
+	// Live check
+	// (GET /live)
+	GetLive(ctx context.Context, request GetLiveRequestObject) (GetLiveResponseObject, error)
+	// Readiness check
+	// (GET /ready)
+	GetReady(ctx context.Context, request GetReadyRequestObject) (GetReadyResponseObject, error)
+	// Get items referencing a document
+	// (GET /related/{id}/items)
+	GetRelatedIdItems(ctx context.Context, request GetRelatedIdItemsRequestObject) (GetRelatedIdItemsResponseObject, error)
+	// Get all webhooks
+	// (GET /webhooks)
+	GetWebhooks(ctx context.Context, request GetWebhooksRequestObject) (GetWebhooksResponseObject, error)
+	// Create a webhook
+	// (POST /webhooks)
+	PostWebhooks(ctx context.Context, request PostWebhooksRequestObject) (PostWebhooksResponseObject, error)
+	// Get dead letters
+	// (GET /webhooks/dead-letters)
+	GetWebhooksDeadLetters(ctx context.Context, request GetWebhooksDeadLettersRequestObject) (GetWebhooksDeadLettersResponseObject, error)
+	// Retry a dead letter
+	// (POST /webhooks/dead-letters/{id}/retry)
+	PostWebhooksDeadLettersIdRetry(ctx context.Context, request PostWebhooksDeadLettersIdRetryRequestObject) (PostWebhooksDeadLettersIdRetryResponseObject, error)
+	// Delete a webhook
+	// (DELETE /webhooks/{id})
+	DeleteWebhooksId(ctx context.Context, request DeleteWebhooksIdRequestObject) (DeleteWebhooksIdResponseObject, error)
+	// Get webhook by ID
+	// (GET /webhooks/{id})
+	GetWebhooksId(ctx context.Context, request GetWebhooksIdRequestObject) (GetWebhooksIdResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetHealth operation middleware
+func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealth")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
+		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetItems operation middleware
+func (sh *strictHandler) GetItems(w http.ResponseWriter, r *http.Request, params GetItemsParams) {
+	var request GetItemsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetItems(ctx, request.(GetItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetItems")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetItemsResponseObject); ok {
+		if err := validResponse.VisitGetItemsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostItems operation middleware
+func (sh *strictHandler) PostItems(w http.ResponseWriter, r *http.Request) {
+	var request PostItemsRequestObject
+
+	var body PostItemsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostItems(ctx, request.(PostItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostItems")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostItemsResponseObject); ok {
+		if err := validResponse.VisitPostItemsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostItemsBatch operation middleware
+func (sh *strictHandler) PostItemsBatch(w http.ResponseWriter, r *http.Request) {
+	var request PostItemsBatchRequestObject
+
+	var body PostItemsBatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostItemsBatch(ctx, request.(PostItemsBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostItemsBatch")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostItemsBatchResponseObject); ok {
+		if err := validResponse.VisitPostItemsBatchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetItemsChanges operation middleware
+func (sh *strictHandler) GetItemsChanges(w http.ResponseWriter, r *http.Request, params GetItemsChangesParams) {
+	var request GetItemsChangesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetItemsChanges(ctx, request.(GetItemsChangesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetItemsChanges")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetItemsChangesResponseObject); ok {
+		if err := validResponse.VisitGetItemsChangesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetItemsEvents operation middleware
+func (sh *strictHandler) GetItemsEvents(w http.ResponseWriter, r *http.Request, params GetItemsEventsParams) {
+	var request GetItemsEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetItemsEvents(ctx, request.(GetItemsEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetItemsEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetItemsEventsResponseObject); ok {
+		if err := validResponse.VisitGetItemsEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetItemsExport operation middleware
+func (sh *strictHandler) GetItemsExport(w http.ResponseWriter, r *http.Request, params GetItemsExportParams) {
+	var request GetItemsExportRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetItemsExport(ctx, request.(GetItemsExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetItemsExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetItemsExportResponseObject); ok {
+		if err := validResponse.VisitGetItemsExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetItemsExportCsv operation middleware
+func (sh *strictHandler) GetItemsExportCsv(w http.ResponseWriter, r *http.Request, params GetItemsExportCsvParams) {
+	var request GetItemsExportCsvRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetItemsExportCsv(ctx, request.(GetItemsExportCsvRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetItemsExportCsv")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetItemsExportCsvResponseObject); ok {
+		if err := validResponse.VisitGetItemsExportCsvResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostItemsImport operation middleware
+func (sh *strictHandler) PostItemsImport(w http.ResponseWriter, r *http.Request, params PostItemsImportParams) {
+	var request PostItemsImportRequestObject
+
+	request.Params = params
+	request.ContentType = r.Header.Get("Content-Type")
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostItemsImport(ctx, request.(PostItemsImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostItemsImport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostItemsImportResponseObject); ok {
+		if err := validResponse.VisitPostItemsImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetItemsId operation middleware
+func (sh *strictHandler) GetItemsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetItemsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetItemsId(ctx, request.(GetItemsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetItemsId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetItemsIdResponseObject); ok {
+		if err := validResponse.VisitGetItemsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutItemsId operation middleware
+func (sh *strictHandler) PutItemsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request PutItemsIdRequestObject
+
+	request.Id = id
+
+	var body PutItemsIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutItemsId(ctx, request.(PutItemsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutItemsId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutItemsIdResponseObject); ok {
+		if err := validResponse.VisitPutItemsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetItemsIdRelated operation middleware
+func (sh *strictHandler) GetItemsIdRelated(w http.ResponseWriter, r *http.Request, id UUIDPathParameter) {
+	var request GetItemsIdRelatedRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetItemsIdRelated(ctx, request.(GetItemsIdRelatedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetItemsIdRelated")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetItemsIdRelatedResponseObject); ok {
+		if err := validResponse.VisitGetItemsIdRelatedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostItemsIdRelated operation middleware
+func (sh *strictHandler) PostItemsIdRelated(w http.ResponseWriter, r *http.Request, id UUIDPathParameter) {
+	var request PostItemsIdRelatedRequestObject
+
+	request.Id = id
+
+	var body PostItemsIdRelatedJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostItemsIdRelated(ctx, request.(PostItemsIdRelatedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostItemsIdRelated")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostItemsIdRelatedResponseObject); ok {
+		if err := validResponse.VisitPostItemsIdRelatedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteItemsIdRelatedNestedId operation middleware
+func (sh *strictHandler) DeleteItemsIdRelatedNestedId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter) {
+	var request DeleteItemsIdRelatedNestedIdRequestObject
+
+	request.Id = id
+	request.NestedId = nestedId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteItemsIdRelatedNestedId(ctx, request.(DeleteItemsIdRelatedNestedIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteItemsIdRelatedNestedId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteItemsIdRelatedNestedIdResponseObject); ok {
+		if err := validResponse.VisitDeleteItemsIdRelatedNestedIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetItemsIdRelatedNestedId operation middleware
+func (sh *strictHandler) GetItemsIdRelatedNestedId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter) {
+	var request GetItemsIdRelatedNestedIdRequestObject
+
+	request.Id = id
+	request.NestedId = nestedId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetItemsIdRelatedNestedId(ctx, request.(GetItemsIdRelatedNestedIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetItemsIdRelatedNestedId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetItemsIdRelatedNestedIdResponseObject); ok {
+		if err := validResponse.VisitGetItemsIdRelatedNestedIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutItemsIdRelatedNestedId operation middleware
+func (sh *strictHandler) PutItemsIdRelatedNestedId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter) {
+	var request PutItemsIdRelatedNestedIdRequestObject
+
+	request.Id = id
+	request.NestedId = nestedId
+
+	var body PutItemsIdRelatedNestedIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutItemsIdRelatedNestedId(ctx, request.(PutItemsIdRelatedNestedIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutItemsIdRelatedNestedId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutItemsIdRelatedNestedIdResponseObject); ok {
+		if err := validResponse.VisitPutItemsIdRelatedNestedIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetItemsIdRelatedNestedIdRelated operation middleware
+func (sh *strictHandler) GetItemsIdRelatedNestedIdRelated(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter) {
+	var request GetItemsIdRelatedNestedIdRelatedRequestObject
+
+	request.Id = id
+	request.NestedId = nestedId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetItemsIdRelatedNestedIdRelated(ctx, request.(GetItemsIdRelatedNestedIdRelatedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetItemsIdRelatedNestedIdRelated")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetItemsIdRelatedNestedIdRelatedResponseObject); ok {
+		if err := validResponse.VisitGetItemsIdRelatedNestedIdRelatedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetItemsId400JSONResponse ErrorResponse
+// PostItemsIdRelatedNestedIdRelated operation middleware
+func (sh *strictHandler) PostItemsIdRelatedNestedIdRelated(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter) {
+	var request PostItemsIdRelatedNestedIdRelatedRequestObject
 
-func (response GetItemsId400JSONResponse) VisitGetItemsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Id = id
+	request.NestedId = nestedId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostItemsIdRelatedNestedIdRelatedJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetItemsId404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostItemsIdRelatedNestedIdRelated(ctx, request.(PostItemsIdRelatedNestedIdRelatedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostItemsIdRelatedNestedIdRelated")
+	}
 
-func (response GetItemsId404JSONResponse) VisitGetItemsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostItemsIdRelatedNestedIdRelatedResponseObject); ok {
+		if err := validResponse.VisitPostItemsIdRelatedNestedIdRelatedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetItemsId500JSONResponse ErrorResponse
+// DeleteItemsIdRelatedNestedIdRelatedAtomId operation middleware
+func (sh *strictHandler) DeleteItemsIdRelatedNestedIdRelatedAtomId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter, atomId AtomIDPathParameter) {
+	var request DeleteItemsIdRelatedNestedIdRelatedAtomIdRequestObject
 
-func (response GetItemsId500JSONResponse) VisitGetItemsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
+	request.NestedId = nestedId
+	request.AtomId = atomId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteItemsIdRelatedNestedIdRelatedAtomId(ctx, request.(DeleteItemsIdRelatedNestedIdRelatedAtomIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteItemsIdRelatedNestedIdRelatedAtomId")
+	}
 
-type PutItemsIdRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *PutItemsIdJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PutItemsIdResponseObject interface {
-	VisitPutItemsIdResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteItemsIdRelatedNestedIdRelatedAtomIdResponseObject); ok {
+		if err := validResponse.VisitDeleteItemsIdRelatedNestedIdRelatedAtomIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutItemsId200JSONResponse Item
-
-func (response PutItemsId200JSONResponse) VisitPutItemsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetItemsIdRelatedNestedIdRelatedAtomId operation middleware
+func (sh *strictHandler) GetItemsIdRelatedNestedIdRelatedAtomId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter, atomId AtomIDPathParameter) {
+	var request GetItemsIdRelatedNestedIdRelatedAtomIdRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
+	request.NestedId = nestedId
+	request.AtomId = atomId
 
-type PutItemsId400JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetItemsIdRelatedNestedIdRelatedAtomId(ctx, request.(GetItemsIdRelatedNestedIdRelatedAtomIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetItemsIdRelatedNestedIdRelatedAtomId")
+	}
 
-func (response PutItemsId400JSONResponse) VisitPutItemsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetItemsIdRelatedNestedIdRelatedAtomIdResponseObject); ok {
+		if err := validResponse.VisitGetItemsIdRelatedNestedIdRelatedAtomIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutItemsId404JSONResponse ErrorResponse
-
-func (response PutItemsId404JSONResponse) VisitPutItemsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// PutItemsIdRelatedNestedIdRelatedAtomId operation middleware
+func (sh *strictHandler) PutItemsIdRelatedNestedIdRelatedAtomId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, nestedId NestedIDPathParameter, atomId AtomIDPathParameter) {
+	var request PutItemsIdRelatedNestedIdRelatedAtomIdRequestObject
 
-type PutItemsId500JSONResponse ErrorResponse
+	request.Id = id
+	request.NestedId = nestedId
+	request.AtomId = atomId
 
-func (response PutItemsId500JSONResponse) VisitPutItemsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var body PutItemsIdRelatedNestedIdRelatedAtomIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutItemsIdRelatedNestedIdRelatedAtomId(ctx, request.(PutItemsIdRelatedNestedIdRelatedAtomIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutItemsIdRelatedNestedIdRelatedAtomId")
+	}
 
-type GetLiveRequestObject struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetLiveResponseObject interface {
-	VisitGetLiveResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutItemsIdRelatedNestedIdRelatedAtomIdResponseObject); ok {
+		if err := validResponse.VisitPutItemsIdRelatedNestedIdRelatedAtomIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetLive200JSONResponse struct {
-	Status  *string `json:"status,omitempty"`
-	Version *string `json:"version,omitempty"`
-}
+// GetLive operation middleware
+func (sh *strictHandler) GetLive(w http.ResponseWriter, r *http.Request) {
+	var request GetLiveRequestObject
 
-func (response GetLive200JSONResponse) VisitGetLiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLive(ctx, request.(GetLiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLive")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Health check
-	// (GET /health)
-	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
-	// Get all items
-	// (GET /items)
-	GetItems(ctx context.Context, request GetItemsRequestObject) (GetItemsResponseObject, error)
-	// Create a new item
-	// (POST /items)
-	PostItems(ctx context.Context, request PostItemsRequestObject) (PostItemsResponseObject, error)
-	// Get item by ID
-	// (GET /items/{id})
-	GetItemsId(ctx context.Context, request GetItemsIdRequestObject) (GetItemsIdResponseObject, error)
-	// Update item
-	// (PUT /items/{id})
-	PutItemsId(ctx context.Context, request PutItemsIdRequestObject) (PutItemsIdResponseObject, error)
-	// Live check
-	// (GET /live)
-	GetLive(ctx context.Context, request GetLiveRequestObject) (GetLiveResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLiveResponseObject); ok {
+		if err := validResponse.VisitGetLiveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetReady operation middleware
+func (sh *strictHandler) GetReady(w http.ResponseWriter, r *http.Request) {
+	var request GetReadyRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReady(ctx, request.(GetReadyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReady")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReadyResponseObject); ok {
+		if err := validResponse.VisitGetReadyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// GetRelatedIdItems operation middleware
+func (sh *strictHandler) GetRelatedIdItems(w http.ResponseWriter, r *http.Request, id UUIDPathParameter, params GetRelatedIdItemsParams) {
+	var request GetRelatedIdItemsRequestObject
 
-// GetHealth operation middleware
-func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
-	var request GetHealthRequestObject
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+		return sh.ssi.GetRelatedIdItems(ctx, request.(GetRelatedIdItemsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealth")
+		handler = middleware(handler, "GetRelatedIdItems")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
-		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRelatedIdItemsResponseObject); ok {
+		if err := validResponse.VisitGetRelatedIdItemsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -700,25 +4154,23 @@ func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetItems operation middleware
-func (sh *strictHandler) GetItems(w http.ResponseWriter, r *http.Request, params GetItemsParams) {
-	var request GetItemsRequestObject
-
-	request.Params = params
+// GetWebhooks operation middleware
+func (sh *strictHandler) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	var request GetWebhooksRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetItems(ctx, request.(GetItemsRequestObject))
+		return sh.ssi.GetWebhooks(ctx, request.(GetWebhooksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetItems")
+		handler = middleware(handler, "GetWebhooks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetItemsResponseObject); ok {
-		if err := validResponse.VisitGetItemsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhooksResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -726,11 +4178,11 @@ func (sh *strictHandler) GetItems(w http.ResponseWriter, r *http.Request, params
 	}
 }
 
-// PostItems operation middleware
-func (sh *strictHandler) PostItems(w http.ResponseWriter, r *http.Request) {
-	var request PostItemsRequestObject
+// PostWebhooks operation middleware
+func (sh *strictHandler) PostWebhooks(w http.ResponseWriter, r *http.Request) {
+	var request PostWebhooksRequestObject
 
-	var body PostItemsJSONRequestBody
+	var body PostWebhooksJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -738,18 +4190,18 @@ func (sh *strictHandler) PostItems(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostItems(ctx, request.(PostItemsRequestObject))
+		return sh.ssi.PostWebhooks(ctx, request.(PostWebhooksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostItems")
+		handler = middleware(handler, "PostWebhooks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostItemsResponseObject); ok {
-		if err := validResponse.VisitPostItemsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostWebhooksResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -757,25 +4209,25 @@ func (sh *strictHandler) PostItems(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetItemsId operation middleware
-func (sh *strictHandler) GetItemsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request GetItemsIdRequestObject
+// GetWebhooksDeadLetters operation middleware
+func (sh *strictHandler) GetWebhooksDeadLetters(w http.ResponseWriter, r *http.Request, params GetWebhooksDeadLettersParams) {
+	var request GetWebhooksDeadLettersRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetItemsId(ctx, request.(GetItemsIdRequestObject))
+		return sh.ssi.GetWebhooksDeadLetters(ctx, request.(GetWebhooksDeadLettersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetItemsId")
+		handler = middleware(handler, "GetWebhooksDeadLetters")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetItemsIdResponseObject); ok {
-		if err := validResponse.VisitGetItemsIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhooksDeadLettersResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksDeadLettersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -783,32 +4235,51 @@ func (sh *strictHandler) GetItemsId(w http.ResponseWriter, r *http.Request, id o
 	}
 }
 
-// PutItemsId operation middleware
-func (sh *strictHandler) PutItemsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request PutItemsIdRequestObject
+// PostWebhooksDeadLettersIdRetry operation middleware
+func (sh *strictHandler) PostWebhooksDeadLettersIdRetry(w http.ResponseWriter, r *http.Request, id UUIDPathParameter) {
+	var request PostWebhooksDeadLettersIdRetryRequestObject
 
 	request.Id = id
 
-	var body PutItemsIdJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostWebhooksDeadLettersIdRetry(ctx, request.(PostWebhooksDeadLettersIdRetryRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostWebhooksDeadLettersIdRetry")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostWebhooksDeadLettersIdRetryResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksDeadLettersIdRetryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteWebhooksId operation middleware
+func (sh *strictHandler) DeleteWebhooksId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter) {
+	var request DeleteWebhooksIdRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutItemsId(ctx, request.(PutItemsIdRequestObject))
+		return sh.ssi.DeleteWebhooksId(ctx, request.(DeleteWebhooksIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutItemsId")
+		handler = middleware(handler, "DeleteWebhooksId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutItemsIdResponseObject); ok {
-		if err := validResponse.VisitPutItemsIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteWebhooksIdResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhooksIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -816,23 +4287,25 @@ func (sh *strictHandler) PutItemsId(w http.ResponseWriter, r *http.Request, id o
 	}
 }
 
-// GetLive operation middleware
-func (sh *strictHandler) GetLive(w http.ResponseWriter, r *http.Request) {
-	var request GetLiveRequestObject
+// GetWebhooksId operation middleware
+func (sh *strictHandler) GetWebhooksId(w http.ResponseWriter, r *http.Request, id UUIDPathParameter) {
+	var request GetWebhooksIdRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLive(ctx, request.(GetLiveRequestObject))
+		return sh.ssi.GetWebhooksId(ctx, request.(GetWebhooksIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLive")
+		handler = middleware(handler, "GetWebhooksId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetLiveResponseObject); ok {
-		if err := validResponse.VisitGetLiveResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhooksIdResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -843,29 +4316,109 @@ func (sh *strictHandler) GetLive(w http.ResponseWriter, r *http.Request) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xY23LbNhN+FQz+/5KS6FPd6qpO0qaaySSuXfcm4+msyKWEhAAYYOlY4+G7dwBSFGlB",
-	"BzuJ48z0ThQXe/z2WyzveKJloRUqsnx8xwswIJHQ+Kc3Qgr6s0SzOF/+7/5O0SZGFCS04mP+tpRTNExn",
-	"TBBKy0gzg1QaxSMunMAnp4BHXIFEPua5U8ojbpM5SnD6JNwKWUo+PojjOOJSqOYx4rQo3BmhCGdoeFVF",
-	"/F2WWXyMV/ajKDb4pL3OvlNLL+KgF1dXk1fnQPMtLlyg1aVJkDnZpeECaL6yK1IecYOfSmEw5WMyJXZ9",
-	"yLSRQHzMy9JLNn5YMkLNeOX8MGgLrSz6er2A9AI/lWjJPSVaESr/E4oiFwk4x0YfrPPurmPm/wYzPub/",
-	"G62wMKrf2tFvxmhz0RipTfajfAEpM7VRNmBC3UAuUiZUURLroKmK+EQRGgX5JZobNF7x07m5NM6st87Q",
-	"m68i/lbT77pU6dO58tccmS0wEZlAl7sGJZ/BMqWJZd4bd6zR6AyekZa+P40u0JCo6y3SddQ1YNsBnSUC",
-	"79Yw1cXj+xqgXvS61aGnHzAhp6Mf6pp7uCwx3oIscnd2Qig7UUYB82tW3Jl15YlBIEz/AVrPgbfiBYRW",
-	"jIRESyCLblpSIBy4N6HchPJ6VpIezFChcWbZQ9MccNC/ChwxmDsTvsCOu3YB7i1aJ75KHRgDC/dcFun2",
-	"HOVgidVSD07TRqRE3dpcbyjoSy+yXtbd+ZJw+wbVjOZ8fHhy4sfF8vngG2bzXrgbe8K5euUz+oMHt9Ie",
-	"CrPR9BSU9PAwPVvuCnI7tV2WSYLWbia3FAiCM9/LM/86oFeitTDDPiu+KxyrOK5yweToCMbWDmRlni/2",
-	"4Un3l1CZXs4xSHzTowSRu4NlUWhDvzZGh4mWq2vI2fmEXdYCfG1cnbVOsZcXV6+YE860ae5VEhTMULqp",
-	"GXES5OO5QKFSvEXTnuARv0Fja40Hw3gYO0O6QAWF4GN+NIyHRzzy1yOf3tEcIXewv+MzDNDX32hEtmA0",
-	"R+8QqNSnfAoWWaKVwsTn0xJQabk3Vad4kvIxf430R63/3g3qMI4fdBHoY6Kx1qttHccihPc2I135w+HB",
-	"yTDet+L3SnU+YcKypckq4ifx0RfEExjgoSRnIHIMdnQoIaXamJIHxLjS4m9KpZRgFnzM67KyZI7JR2cA",
-	"ZtY1e1Ptayc8ajkkiKwLJCPwBhmwAmZC+XGfC0tumYA8r4EfgtSkedFdot5/rXVp85bwoPVpzz3Jd3gT",
-	"vhPcsTZtcW7bGnX9VbuvLeteM8LfKgN3JtIEeWcKdZe+3vjwRq73QOxly+SshUzdm/F33396vfMaqQfw",
-	"ZfM0oVYRL7QNdEx9l2PAFH72h9lnQXMGwRtzv2vOtW3bptkiX+h08dXy0rlqVv0KOqBWX4i/3fgKFKLd",
-	"Te4P+Srix08JiS2b+7OE5xrMAhBt6X10J9JqH45vNvGkBu50wQTZMFaXDD9Jd3G8r/Gjv/qsRmV2fArJ",
-	"QTwdnPycJIPjo9PDAZz8dDqIMT6cHiZH6fHpL7sv1l9Ms4+B+SbSO35aVNWYdqVgTZa8D8dP6EP/i8dz",
-	"5f0l+j1mA8RfBrqoXnMZKIa3wpJQs1ZNmOzLH7mBvs1waj4VPKfh1Hw1+r7DKdC6TBs2Xc2s/zp5vZOb",
-	"jtwyHHNxg/su1ZuX5zdOyzdanbetyY/fF8EH3s+Wi2LLpli1fwbpafXlYzXhbIejfNLXF67ugspQpYUW",
-	"ijrnGvvVdfVvAAAA///a2ZjsnBsAAA==",
+	"H4sIAAAAAAAC/+xde3MbN5L/Kqi5+2tvSMm2HGd9dVXnWHGWd07sk+xkq0KXCc40SaxnAAbASOK69N2v",
+	"ugHMg8TwIUuynfVfEjkDoNFo/PoJ8GOSqXKpJEhrkqcfkyXXvAQLmj49s6ocnb7mdvE6fI9f52AyLZZW",
+	"KJk8pZfY27ej0yRNBH6x5HaRpInkJSRPE4595EmaaPijEhry5KnVFaSJyRZQcuxvpnTJbfI0qSqBb9rV",
+	"Elsaq4WcJ9fXafJSlML+XwV6tYWQX6pyCpqpGRMWSsOsYhpspWUg7A/soKGswE6TNiElvxJlVSZPHxwf",
+	"H6dJKaT/WNMkpIU5aCLqFzAW8p38ca+xXGVVCdJuYZV0HX4qs17NZgZuwi3zQSx7eKWozy6zAneOo9x5",
+	"+3YnZ87AqEpnsIUl4tOYcY2NzVJJAyTQP/D8DP6owFj8lClpQdK/fLksRMaRsKOlVtMCyv/4h0EqP7aG",
+	"+3cNs+Rp8m9HzaY5ck/N0WvXyg3anecPPGfaDcsGTMgLXoicCbmsLGttuOs0ea7krBDZvVL3ZgE1dZkf",
+	"37BLYRfMLoBlldYotsZyCygult53K4c0/6Qk3De9ZiUzZtUHkGzBDYOrJcnHdZqMpAUteXEO+gL0j1or",
+	"fZ/EheGZofEZEAEIFsq+UJXM751TS8jETEBerxm75IZJZdmM6LlOk9caMiVzgc1ecFHAvVLZHr2RLyeP",
+	"wlFagkU6cU1FBm8lv+Ci4NPiXuXu3CrN54A0WSiXSnMtihWrWtRgI99TUJ/4d6nVErQVDoNEvomEHgB3",
+	"wFlAxY8R0G8w8ncHmvTqu7oPNf0HZMRFpOrtMucWNmkL/Zf86iXIuV0kTx8+fkyaMHx+kO4YvXfg5wsu",
+	"53AOdnPcBTfvS6VhkzM/Kw0so5aGcQ2s5jbTYr6wjF/yVcOqqVIFcInDkVojhod/ti38yOKq1/1wrfkK",
+	"P0u4sptUnTf4M1OaRBZfDHIbWzqryqmxSsL+NL0JTTYJW19x6q4ziCc9bXgbW5NTKMQFavqNJeEWpdxZ",
+	"hevqPU3gwm+5bfT/BtOFUh9e81WhOCGNk/2dYl5wY99DwO6Nxzix956+99x2ukS5HlhRQqzfS0fQ+73I",
+	"iG2qVgeBB2nDqU3KtrH8pTCRnZC7p+IAManXcJeUtPqOEfY34IVdPF9A9mGTrno14IqXS0TeRIOQOVyB",
+	"RrNBQkYALgwrVHwHFNyCzFbvS9Pp58Hw4eMW9OWqQiit20uyU9vgF6EgNpyx3FY7mecmfe7ejUJZ3VNn",
+	"Bv0MPIOl0pGVzZCx+69qezUiuHST2aXJBWgjlOxy8cHwZHi8U/5rJoQ+0jCjflac10SCRE/h92TJDfYx",
+	"46JI3m0MmSYjVKz2pZDQpzh3g4dv3EVs7NKbFpnSOZqz2hrGW5LagjcN3JsNW8QqzCnTwC0gLdUy9/+h",
+	"K7Wk/zQgTyCPTHeNw0R4PUCMq447fQLGp0pbiFgXaAcKakq2n7EKaWPTFeO1xZ+2mGMYn1nQTFh2CRrI",
+	"BFtqlYExkEd1Lc8yWEbHbtxMzybGZc48o1ittzZWINer97qSkQ6VXQg5p5lcamEtyEA7zdAs1KVhlwtu",
+	"mW3NW1UF+uBR6pHxB1gKjYhG9mW93FG1GcQi8nBNGGqOtrpsi1XgT1ove5hHVHCcz0m+eL/Vt2aXc/QA",
+	"leOia89mAgocqQ3BCIr57w/fDcO/x++G8Z3Z7KqmfVkZSwI2BYZac5XsZV/WnUVnayEySy9/3mBYc9ss",
+	"lE5AUYdZUYKxvFy2TfKthkXMpn9WWTWYgwRNon6oiR8h0M88wldi/N4S7CJSMen1+7KfR2iZ+d17MJt6",
+	"vZS0vTZ9C/oDt9lic1U1mKqwJhpcwgdMSJJgpXMHQy330q+KSdL9fQQiw/W9094KtG2dUiseta7uupi0",
+	"U3K2ewj5TjpoThtkzELUosveF7wwwATxU4Nz1AnOQ+RImCDyEddsP00u/D7e7bfFRMsR3jfn5yRx+2Jh",
+	"e/sd5Bvf3ubc18tGUn8M3tnuyMM5ip/MgMlaUeMOCb5Ns0ZC2u9Ooqoap/V+zyUlZNjbX3Nf7BYAmu8b",
+	"fDkqCdRNQ6enYivz3viRt9l4ORQQN+xcR3EH73bCEr2w90oWK4pJ8DqIRhtyInIzSZn+DKiYJlZZXuxh",
+	"9Ljx+hZmV9Dqq9iyTe+xab4UFyDBmM1JttyO2nbi6M7H9s1tunkxMv2E7yO0efhqUNQ15jF7p2nNylWm",
+	"E3mW3WxdyhZivgDN5goMmwltOqjYB4oHBWQdN281JHv7XDt0ynsIe4i1b6wJZW1Y6zvGM/RL0e2zip29",
+	"eM6efH/8JEk3YmcW4wodqSeTZHbyhGcPjqeDx99n2eDk0ZOHA/74uyeDYzh+OH2YPcpPnvy1lRKJmSLk",
+	"9L3Xteu920P0bjo2lsZyma0Fr45oXY72oa2zf7QYaJiBBuwxRqrz1d5TZvEATdN2EaMah7TD+9geH52u",
+	"6RDnkRteAuO1svn7wNu6g9EpC2lZtgCOOgijAv49nzor1Nx0XM2/Th/OTrIHMPg+f8QHJ7PvpgP+IHs4",
+	"eAQn+ePZd/zJ9PtseyCw7uvk+CRmyVhhi7VV+kVZ9qJPKoJ90lpUn3YyR1LZQRCnfRdvbQ95u8VRtTUw",
+	"dF5lGRhz5pkaCStzy6M+klsEehzptwRj+Hxtjq+WoJ2jjGJENhAzjoBZVRRxD36j6ya5EYuBQ+OFHuKB",
+	"3yCk3xosxlmfw9gVTtiPSLKqDzP+Wqbt5p7c0+iudLFn0hDfrMnc6ZZ73vS5Us1s1+Adv2fYG1Wd+KxE",
+	"ynhR+G8vFyCZKoV1hvbtMMtApiFiAfwvrAJ6/e3nZ88H53979vDxd4GsFTNiLrmtNGxhbbM5FtYuzdOj",
+	"I//NMFPlETLJHK3FOnuWwa2Ap3UL0+POhc9P7S9ivredtmvd8RaSQp5vg8M/qJxYzJnvpmbuhgqPaRcn",
+	"L6PTllJBBwdodepl6nVctwQX/hRe6zXp/JkKtRDclQ9BSbZQYqol2iH/3RLIprzq2esRO3cvJBsFD89q",
+	"fGfPz96eMnwZGe/qxUou+RxKx22vOJOzOiUYWrQ8CXRBjofHOJBaguRLkTxNHg2Ph49QDLhdkAQcLSh1",
+	"hP/OY9v1rJKEFO415nJQZD74BAAJCalK+tbn7FA+gGcLLyfIAxX02ChPniY/gXVJq2StZuzh8fGWMpPD",
+	"yks6GcJIjcmzoggzWnLKtlynyePjR/dHgGUFcGOZkuAoYTNXEYTvmqosuV4lT31+z72RpInlc4OC675O",
+	"3uHLRzUAxdcRrBZwAYyzJZ8LSUHyQuDQM1pgaj5kv6EuoOgFBhnn6CA7IMB3QwqJKkNcsSfkY+kNySag",
+	"4eIcQhoL3GWgJj4SMmEl1x+MR5NuCARtVzLhmNLoHqRjOaGi0UnKJq4ickKdzURhQRuWcelTGZkqp0JC",
+	"3gq+jGVM5kZeK7QLb3/fu7I1ZQE2WCULMGaNU3dT+bpnKSmBhV9boW5K68GVp+vUPVdlyZkB5HBbxsgz",
+	"xGUmgmdgswW5G8tC5ZA8nfHCQJwkkZsOPTcN0aeJsSvCTWyYbJJ+RovMFMb0HGsJ4IUkT3gzWrEZe4+R",
+	"731yp1QOKCw+mDpuVbkfSfjmofS8u0OcrsO3sTrA2tVh9W7GtTw5Pu7rtqbzqFWATMi+R5NYRWujFba3",
+	"jVRMdnH8J7AN2LaA3Edir9NkqUwEvZ3NzziTcNlK+/BozrMLeq+VqVHP4y0aiLe6do68UADerh6/vmOp",
+	"iRYF19nldU/5ZmJzcvzX3U3qUvIvQs425CUia7XNcDStc73KbDMdNsyAuOJPmcDVzSEnlYQtEPMVK5Sc",
+	"+2QJARLz4NIrsi4JfXdy28kIfwbpdfP7VwA9Jy/TVZ3r6pdGX32825L1StBvdKXrMidXTmW7BxfQcGzq",
+	"dRvryQek6hqstLZn62ydp2jIzi3XTuuryrKJETIDZ5Ki+8AmWI86Ca7xUsOFUJVpYq80xCXXuUnH8gPA",
+	"MuwcKrBaiALYJJQPk6GGMjhkP5Kt7IjAb4PhzeCKZ7ZYMSUzSAOVrOQ5jGWmpD9KUqycsiDTcE6mu0Tz",
+	"m/nqpiF707AFn36ApfXblCxXyKn2JGXcs1IVOeixtAvuGVXkYKxrV7PYUepKqRwBJw+O3SqEhVlwZ7wS",
+	"VxWStMbaLTb8cy8lO0z5V0v+R9WRg5lWJZHgV4tqrHrXrMeAIvI65tNO8+1nZ/K3Ev9hwQ4/OJfDjFP1",
+	"yAPyHg5wJu7SimsOHNwtop082KPJT76O/8uAv7DUJDdOj6LXj2K5FQubmG4UCh29g3OQllF4y6BKBV7W",
+	"DlcbFJhdaFXNvXsQsmQBX2gotyVxc4h8MpZub9DmFdh3t3glbSKAlPknxFqE4yMUW673O2Y88DmXbFIH",
+	"4ybDsXxGRbCunl3OWVYI7M6AzE3DJw0ZiAs0OyhrNXnJjR1QF4PR6cRtIHqDmpTCGERHYshwLCmkYRew",
+	"ctjn7BDQhFduDhidwOCCm4vAmUrr0s/1DAJlCypt1YCxV3rgAidjiY4vvkFloTQU9bIELVQuMl4UK4xw",
+	"LIBrOwVuzRZ0+zGkBLaC236udmCN4wcC+5fmd/fNA7qZCz+RPamnZnH6Py2vsX0uPQVeXTEOUXOi22Vk",
+	"G8I7wt3jooeqgK2hmd1Yb+HKOowZONzogn3keG8sVeCb3gTMO0A5agCLzQDy7ch4FUoD4sjoYLD2tVmJ",
+	"pnaIlIQAIjfoIOGGpcSGs3X+5/zVLykFZGkfLUHTlh6yUSv0yfOxrC0JBLcpN+BtOH9i19l8olU2b1x1",
+	"tyOdGfFPCCcuw9jTFSuhVHo1HMuRM64mVnNpcOkhn7BS5cHwLTCzE0zAQnwAgsaffnzDPIs+ivzaWadz",
+	"FatAG8uJUTqYQGl4ZaL5ZWegBb+AVi4IXzZsWqHBB0sfyb3gRdWgvbFKB29wLNVsPXBm0j6yalP7B4Xx",
+	"bi4xuuuqQZA9PPvgQ7yvX53XE3XPUZu8WWP9/J9iSWkVTYcpXKazheau7N8wYYdsNGuvDobhTcuTcMoA",
+	"BWEs6VQHVStRV53TWP54QFhrPw5OxEJRoAmtK5kRzIWRUIx4k/xRErZpBmq0SzO4t77USOZO6u42khmJ",
+	"rKJ8gLQ8lKa5pWmdmImNjVskbpYnrT2bpHVVa/dbzS+jh5Q+Ri8MaGR6XZyF9SEWFPY+pfKMBH3wo8xU",
+	"7kIu/TB/mI9wNZD5pp+wU3WQMDuYRcBtJDHg7afrEy9mPmNl2C+nONIeWmWYmYtdmmW7Vnl+/quTX7/P",
+	"CriAwrBZwa0FCQ3YhvR6SYDoAZObCI47F5GM4nLIfgv3RUxwB0xYwVfoN/u0mrr05XxTMMRi2lA+aetM",
+	"T4dcRVVKHwsxTt3hSxtFmabKFozjBCGKyW16XOPJWO4gaR2Bgu4gUgNlZAkKOjioStMyEacrNvlPhpDv",
+	"tHIIHKz1amLzad52vVr+gVCXiKRVo5NRgQgcYw/VQI13K4ad0P7cXHxD99tE99/wbCLvSmDPeE5kezAd",
+	"iWmBuf/oWL0XkPe5OUHYO7ZQ7a/W1iPasuHV6Yp5wobsWYg7jGV4jO0mvpJlkvp/cY71h6bUrP6qOYyG",
+	"X7l5UfuxDJ9CF/4jWY+pgyB802X66RO9OZZ7+mqe7ri39qnepQvaeoCG5qadt29eDL5n05UNLMfKhJSZ",
+	"JVr3ZgFgGeTCKm2YBDIFXPmchcx1B40yjU1qqsrOhGpJ8oxYP6e1r7/mldOhuhbFq1ZLPNQBa3V5+4r2",
+	"+fmvW7Ws0x39CadR2eqv9rLC+ZqpyldDr8wDtJhNfy2iYc1Y9utVYVM/Yh2ERpGeg/VpNIU+IHIQY2Q1",
+	"A4mdXn7H8vY2HevuubHcd9OlDnkbxWQvlXdaXWorBPw6gDiWwUxI8YEJcQviHvF1rpwlQWqPS2UXoOuu",
+	"3CF/YRgVtbvEq8e5YpWO/XHm+rh7A2u4SpR+BJO6gjH6hF/jaGQxc9KhQ/amOXXuc4row6rKZqoEHy9q",
+	"iKn3uRMG21xB4IucWsfV2xrdYGeepLG8hIZWH++k0xK1QZ5xrQWY9pH4ujbfzbY+ys+mMFPaudI4YqVj",
+	"jl6d/HS7YJct8ILU4fpFTm6P/FBrCTYJ0DFhHk9cfobnfsOSGHG5YrSwHXM5Bm5eC7fxLShG7w6kCQLV",
+	"u30VNEKrcg4Nl52TrUEIc8YLJHjF4AqXf8gmmMGasAL4BeAGZpV08YMc9wsmlHDlAPf4suAZuE0+Qd5P",
+	"6FoG0xICt7pO+uqRw3UNIU7jJWEsm0iPF5XwKCyysHUubdyX2FmqQmSrHnMDqWyZG/6ywHpS/bd6bDL4",
+	"V78pmw0oc19t2L2BDlEPe6c8oVzRzQ89tDcXIhyq4fpS+X85+ktXr9WW3lRIrld99w3eW8K+c8woomXp",
+	"uQeBGyfrbyPjtKk/vbZUeqduRqW4TxGpv+guc1t1uiIvLVqAFNybUb4Ly0Yhb3GjyymbQwEHn/H6PBVv",
+	"d50mPT7Z3aS+J/GLqhRBeRqdRuQ0TZZVRC7dYU5UHKQaEL5CN/GauOprFsm7qYVyPPyiavhCPc+t1PB9",
+	"dZvBC/XO6j1E7KPW+ePtyL0RC1OzYHBtQe4z3/3GbonNsXnlaPNW4k9G1U+7IuAb4G4A7rpEtIStPk7e",
+	"W5j8bLkEdBzWe0F7vk+sGg/n1uXq9qExSNL9wmJ71O3Xm/M8/0wI+RWWRT/LN5IBUWmPg+vRx3Bj/HVz",
+	"bDoGtaW6gMiWcInenk1xSr11t8UvzQX1n7w90p2N4vfrR/D6ZHPOpxA5l/5NDvvk0LFrL1FMd3pi62K2",
+	"ny/2ZYvYfSDpN82/n/xFPa4zF1GLKv45UAixLhulLOsWH+yLE8W7siI+j4u1vy3xOf2trxDFvYN2KwbF",
+	"/g6cq1mg6x02R94T7W/R7P5soP8J12x90wWRU6kepA93/SS1JYdvp0Ruun5fnEjePvg7Ibxf0G/GjPx6",
+	"2Def8UCf0df6fAKuH310P8y2l+fod5QvDN61p7Z5jv7js/CjcJ9vb+1uGPv1u2/e5915n71CvdPl9PJ5",
+	"I0fzTyiRd4/a38yTLcK63T+Voeme3uefQz7vxoj5PP7rVlPmm8t6E5d1qz1Dt1z3uaG/ghYzV12I99P5",
+	"2jaqJaskVninLAf0C0Bmwh+Dlcq6aiPIN/bhT2BfujOddyY/9SXfMRl6PULa3c3eXVZhs+0XrlEx2haH",
+	"3f0SzsJFw9zPurgj1/WxM6v5bCYyV9pYP/W1ZtQ9q6QVhatdrJb1ITFDt1RkRUWXNJdi7lhq/L2+OVy5",
+	"Y9WZKAQ9cQfuXNUaVvm1r/Nz5Y/xgwlnNMfPeEXfqMUVx/B7vqJvFFuWNVlBLgmJG2GHwHhfAH2FT7iu",
+	"b+NUxWYUVunaTKuLGenat15zzSu+Ud5zRd6NNN/t/GJ0f4HNPdyjt+uevC3Efdrh7G/XqR1ws1C45dvt",
+	"hp2x2PbFvX7/bWyI38I7d7hS7duFD1isL+ciu8uGSYHT9Vf9ocPzaurOHTDO3p69xJ3Xuq0k3NzhDxfU",
+	"Vw6jomZ07tufYaGa1kn3OuSJq313R8Enfx/4p4PzcK30JJzcqI+OTMyCP3z83X+Nq+PjR9kCrugfPEmx",
+	"fkm1mrGJe6vp+E34WS7XauieIxG+G7zqaQWtAwmeQczdOj1k7jegWfNzoeF6VS1CM7hyayR4QQcu1WxG",
+	"Ch3ox/3qG6ty4HjW1FrQXlmE62bCj6ZS13C14BXqiyE77Q7K7YDuox1gw3Qs3XHRLnFmwf0hhhZ7/X0V",
+	"eeBuz8GGzp66fSele0P6Pfsp9d3em/vYPwp3lX21eFtfK+hFOL7r2wB7hCI5cCK5h7XTEjOLp0JqSUV5",
+	"E7oW4xRPZIGx9U/E9KL3KfD8pR9+72t/22R8KTZKl6Y/h6HS+W3mfwVjpQXQ5sDNEzIM1v96eFSxvq6s",
+	"VwReZ9KNKf5XRf+ooGqdf61VQqYqnDfT4ASlH7ZbmwkjZVavNvfUbVQEx2P8bkY0i/wrDWUSzxhvy8Ee",
+	"YvBRbM8b+YB+27bYrMJZgqRgQYMjPZmksNij/N4W97fmdyrAfrWLG/Iq27VjutPhGH0RdfY3NHX6Ifur",
+	"zDuE/bR+IKm9Va/rr6Onh5pf7mg4YlpHiCjcEtH8sZtMXPHPjh6Dq7vZ56vKzhVdNuunZapp/XztN0Z8",
+	"Z/VEN3tr/ygFeiJLJdzxAd/UPU+u313//wC6xp8j94wAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,48 @@
+package http
+
+import (
+	"context"
+	"crud/internal/domain"
+	"time"
+)
+
+func (s Server) GetLive(_ context.Context, _ GetLiveRequestObject) (GetLiveResponseObject, error) {
+	return GetLive200JSONResponse{Status: "alive", Version: s.Version}, nil
+}
+
+func (s Server) GetHealth(ctx context.Context, _ GetHealthRequestObject) (GetHealthResponseObject, error) {
+	report := s.Checker.Health(ctx)
+	if !report.Healthy() {
+		return GetHealth503JSONResponse(s.healthReportToResponse(report)), nil
+	}
+
+	return GetHealth200JSONResponse(s.healthReportToResponse(report)), nil
+}
+
+func (s Server) GetReady(ctx context.Context, _ GetReadyRequestObject) (GetReadyResponseObject, error) {
+	report := s.Checker.Readiness(ctx)
+	if !report.Healthy() {
+		return GetReady503JSONResponse(s.healthReportToResponse(report)), nil
+	}
+
+	return GetReady200JSONResponse(s.healthReportToResponse(report)), nil
+}
+
+func (s Server) healthReportToResponse(report domain.HealthReport) HealthReport {
+	res := HealthReport{Status: HealthStatusPass, Version: s.Version, Checks: make([]HealthCheck, 0, len(report.Checks))}
+	for _, c := range report.Checks {
+		check := HealthCheck{
+			Name:      c.Name,
+			Status:    HealthStatusPass,
+			LatencyMs: float64(c.Latency) / float64(time.Millisecond),
+		}
+		if c.Err != nil {
+			msg := c.Err.Error()
+			check.Status, check.Error = HealthStatusFail, &msg
+			res.Status = HealthStatusFail
+		}
+		res.Checks = append(res.Checks, check)
+	}
+
+	return res
+}
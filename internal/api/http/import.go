@@ -0,0 +1,87 @@
+package http
+
+import (
+	"context"
+	"crud/internal/domain"
+	"crud/internal/transfer"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"iter"
+	"mime"
+)
+
+func (s Server) PostItemsImport(ctx context.Context, request PostItemsImportRequestObject) (PostItemsImportResponseObject, error) {
+	params := request.Params
+
+	format := PostItemsImportParamsFormatNdjson
+	if mediaType, _, _ := mime.ParseMediaType(request.ContentType); mediaType == "text/csv" {
+		format = PostItemsImportParamsFormatCsv
+	}
+	if params.Format != nil {
+		format = *params.Format
+	}
+
+	var records iter.Seq2[domain.ImportRecord, error]
+	switch format {
+	case PostItemsImportParamsFormatNdjson:
+		records = transfer.DecodeNDJSON(request.Body)
+	case PostItemsImportParamsFormatCsv:
+		records = transfer.DecodeCSV(request.Body)
+	default:
+		return nil, domain.Validation(domain.FieldError{Field: "format", Reason: "has invalid value"})
+	}
+
+	opts := domain.ImportOptions{Policy: domain.ConflictFail, BatchSize: s.ImportBatchSize}
+	if params.Policy != nil {
+		opts.Policy = domain.ConflictPolicy(*params.Policy)
+	}
+	if params.DryRun != nil {
+		opts.DryRun = *params.DryRun
+	}
+
+	report, err := s.Service.ImportItems(ctx, records, opts)
+	if err != nil {
+		return nil, importError{err: err, report: report}
+	}
+
+	return PostItemsImport200JSONResponse(importReportToResponse(report)), nil
+}
+
+// importError несет отчет о записях, обработанных до ошибки импорта: порции, записанные до нее, остаются,
+// и клиент узнает о них из ответа с ошибкой
+type importError struct {
+	err    error
+	report domain.ImportReport
+}
+
+func (e importError) Error() string {
+	return e.err.Error()
+}
+
+func (e importError) Unwrap() error {
+	return e.err
+}
+
+func importReportToResponse(report domain.ImportReport) ImportReport {
+	lines := make([]ImportLine, 0, len(report.Lines))
+	for _, l := range report.Lines {
+		line := ImportLine{Line: l.Line, Status: ImportLineStatus(l.Status)}
+		if !l.ID.IsNil() {
+			id := openapitypes.UUID(l.ID)
+			line.Id = &id
+		}
+		if l.Reason != "" {
+			reason := l.Reason
+			line.Reason = &reason
+		}
+		lines = append(lines, line)
+	}
+
+	return ImportReport{
+		Accepted: report.Accepted,
+		Rejected: report.Rejected,
+		Skipped:  report.Skipped,
+		DryRun:   report.DryRun,
+		Aborted:  report.Aborted,
+		Lines:    lines,
+	}
+}
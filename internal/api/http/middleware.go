@@ -0,0 +1,17 @@
+package http
+
+import (
+	"context"
+	"crud/internal/metrics"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+// OperationMiddleware передает имя операции OpenAPI в метки HTTP метрик и в имя span запроса
+func OperationMiddleware(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error) {
+		metrics.SetOperation(ctx, operationID)
+		trace.SpanFromContext(ctx).SetName(operationID)
+		return f(ctx, w, r, request)
+	}
+}
@@ -0,0 +1,153 @@
+package http
+
+import (
+	"crud/internal/domain"
+	"crud/internal/logging"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+const problemContentType = "application/problem+json"
+
+// statusClientClosedRequest запрос отменил клиент, закрыв соединение. Ответ он уже не получит, статус нужен
+// журналу доступа и метрикам, чтобы отмена не считалась ошибкой сервера
+const statusClientClosedRequest = 499
+
+type problemKind struct {
+	kind   error
+	status int
+	slug   string
+}
+
+// problemKinds сопоставляет виды ошибок предметной области со статусами ответа
+var problemKinds = []problemKind{
+	{domain.ErrValidation, http.StatusBadRequest, "validation"},
+	{domain.ErrNotFound, http.StatusNotFound, "not-found"},
+	{domain.ErrConflict, http.StatusConflict, "conflict"},
+	{domain.ErrPreconditionFailed, http.StatusPreconditionFailed, "precondition-failed"},
+	{domain.ErrGone, http.StatusGone, "gone"},
+	{domain.ErrUnavailable, http.StatusServiceUnavailable, "unavailable"},
+	{domain.ErrCanceled, statusClientClosedRequest, "canceled"},
+}
+
+// HandleRequestError отвечает на ошибки разбора параметров и тела запроса
+func (s Server) HandleRequestError(w http.ResponseWriter, r *http.Request, err error) {
+	var (
+		fields    []InvalidParam
+		reqErr    *RequiredParamError
+		formatErr *InvalidParamFormatError
+	)
+	switch {
+	case errors.As(err, &reqErr):
+		fields = append(fields, InvalidParam{Name: reqErr.ParamName, Reason: "is required"})
+	case errors.As(err, &formatErr):
+		fields = append(fields, InvalidParam{Name: formatErr.ParamName, Reason: "has invalid format"})
+	}
+
+	detail := "request body is malformed"
+	if len(fields) > 0 {
+		detail = "request parameters are invalid"
+	}
+
+	writeProblem(w, r, Problem{
+		Type:          "/problems/validation",
+		Title:         http.StatusText(http.StatusBadRequest),
+		Status:        http.StatusBadRequest,
+		Detail:        &detail,
+		InvalidParams: nilIfEmpty(fields),
+	})
+}
+
+// HandleResponseError превращает ошибку обработчика в ответ application/problem+json.
+// Ошибки, не относящиеся к предметной области, отдаются как 500 без подробностей
+func (s Server) HandleResponseError(w http.ResponseWriter, r *http.Request, err error) {
+	p := s.problemOf(r, err)
+
+	var importErr importError
+	if errors.As(err, &importErr) {
+		report := importReportToResponse(importErr.report)
+		p.ImportReport = &report
+	}
+
+	writeProblem(w, r, p)
+}
+
+func (s Server) problemOf(r *http.Request, err error) Problem {
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		s.logger(r.Context()).Error(err.Error(), "path", r.URL.Path)
+		return internalProblem()
+	}
+
+	kind := problemKindOf(domainErr)
+	if kind.status >= http.StatusInternalServerError {
+		s.logger(r.Context()).Error(err.Error(), "path", r.URL.Path)
+	}
+
+	fields := make([]InvalidParam, 0, len(domainErr.Fields))
+	for _, f := range domainErr.Fields {
+		fields = append(fields, InvalidParam{Name: f.Field, Reason: f.Reason})
+	}
+
+	return Problem{
+		Type:          "/problems/" + kind.slug,
+		Title:         statusText(kind.status),
+		Status:        kind.status,
+		Detail:        &domainErr.Detail,
+		InvalidParams: nilIfEmpty(fields),
+	}
+}
+
+func problemKindOf(err *domain.Error) problemKind {
+	for _, k := range problemKinds {
+		if errors.Is(err, k.kind) {
+			return k
+		}
+	}
+
+	return problemKind{err.Kind, http.StatusInternalServerError, "internal"}
+}
+
+func statusText(status int) string {
+	if status == statusClientClosedRequest {
+		return "Client Closed Request"
+	}
+
+	return http.StatusText(status)
+}
+
+func writeProblem(w http.ResponseWriter, r *http.Request, p Problem) {
+	instance := r.URL.Path
+	p.Instance = &instance
+	if id := logging.RequestID(r.Context()); id != "" {
+		p.RequestId = &id
+	}
+
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// writeInternalProblem отвечает 500 без подробностей, причина есть только в логах
+func writeInternalProblem(w http.ResponseWriter, r *http.Request) {
+	writeProblem(w, r, internalProblem())
+}
+
+func internalProblem() Problem {
+	detail := "internal server error"
+	return Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusInternalServerError),
+		Status: http.StatusInternalServerError,
+		Detail: &detail,
+	}
+}
+
+func nilIfEmpty(fields []InvalidParam) *[]InvalidParam {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return &fields
+}
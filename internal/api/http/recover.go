@@ -0,0 +1,71 @@
+package http
+
+import (
+	"bufio"
+	"github.com/felixge/httpsnoop"
+	"io"
+	"net"
+	"net/http"
+	"runtime/debug"
+)
+
+// Recover перехватывает панику обработчика, пишет ее в лог со стеком и отвечает 500 с ID запроса.
+// http.ErrAbortHandler пробрасывается дальше: им выгрузка обрывает соединение, чтобы клиент увидел
+// неполный ответ. Если ответ уже начат, соединение тоже обрывается, дописать в него ошибку нельзя
+func (s Server) Recover(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var started bool
+		ww := httpsnoop.Wrap(w, httpsnoop.Hooks{
+			WriteHeader: func(next httpsnoop.WriteHeaderFunc) httpsnoop.WriteHeaderFunc {
+				return func(code int) {
+					if code >= http.StatusOK {
+						started = true
+					}
+					next(code)
+				}
+			},
+			Write: func(next httpsnoop.WriteFunc) httpsnoop.WriteFunc {
+				return func(b []byte) (int, error) {
+					started = true
+					return next(b)
+				}
+			},
+			ReadFrom: func(next httpsnoop.ReadFromFunc) httpsnoop.ReadFromFunc {
+				return func(src io.Reader) (int64, error) {
+					started = true
+					return next(src)
+				}
+			},
+			Flush: func(next httpsnoop.FlushFunc) httpsnoop.FlushFunc {
+				return func() {
+					started = true
+					next()
+				}
+			},
+			Hijack: func(next httpsnoop.HijackFunc) httpsnoop.HijackFunc {
+				return func() (net.Conn, *bufio.ReadWriter, error) {
+					started = true
+					return next()
+				}
+			},
+		})
+
+		defer func() {
+			p := recover()
+			if p == nil {
+				return
+			}
+			if p == http.ErrAbortHandler {
+				panic(p)
+			}
+
+			s.logger(r.Context()).Error("panic recovered", "panic", p, "path", r.URL.Path, "stack", string(debug.Stack()))
+			if started {
+				panic(http.ErrAbortHandler)
+			}
+			writeInternalProblem(w, r)
+		}()
+
+		next.ServeHTTP(ww, r)
+	})
+}
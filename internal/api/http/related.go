@@ -0,0 +1,120 @@
+package http
+
+import (
+	"context"
+	"crud/internal/domain"
+	"github.com/gofrs/uuid/v5"
+)
+
+func (s Server) GetItemsIdRelated(ctx context.Context, request GetItemsIdRelatedRequestObject) (GetItemsIdRelatedResponseObject, error) {
+	related, err := s.Service.GetRelated(ctx, uuid.UUID(request.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	return GetItemsIdRelated200JSONResponse(relatedToResponse(related)), nil
+}
+
+func (s Server) PostItemsIdRelated(ctx context.Context, request PostItemsIdRelatedRequestObject) (PostItemsIdRelatedResponseObject, error) {
+	nst := nestedRequestToDomain(*request.Body)
+	if err := s.Service.AddNested(ctx, uuid.UUID(request.Id), nst); err != nil {
+		return nil, err
+	}
+
+	return PostItemsIdRelated200JSONResponse(nestedToResponse(nst)), nil
+}
+
+func (s Server) GetItemsIdRelatedNestedId(ctx context.Context, request GetItemsIdRelatedNestedIdRequestObject) (GetItemsIdRelatedNestedIdResponseObject, error) {
+	nst, err := s.Service.GetNested(ctx, uuid.UUID(request.Id), uuid.UUID(request.NestedId))
+	if err != nil {
+		return nil, err
+	}
+
+	return GetItemsIdRelatedNestedId200JSONResponse(nestedToResponse(nst)), nil
+}
+
+func (s Server) PutItemsIdRelatedNestedId(ctx context.Context, request PutItemsIdRelatedNestedIdRequestObject) (PutItemsIdRelatedNestedIdResponseObject, error) {
+	nst := domain.Nested{
+		ID:      uuid.UUID(request.NestedId),
+		Name:    request.Body.Name,
+		Related: atomsToDomain(request.Body.Related),
+	}
+	if request.Body.Sort != nil {
+		nst.Sort = *request.Body.Sort
+	}
+
+	if err := s.Service.UpdateNested(ctx, uuid.UUID(request.Id), nst); err != nil {
+		return nil, err
+	}
+
+	return PutItemsIdRelatedNestedId200JSONResponse(nestedToResponse(nst)), nil
+}
+
+func (s Server) DeleteItemsIdRelatedNestedId(ctx context.Context, request DeleteItemsIdRelatedNestedIdRequestObject) (DeleteItemsIdRelatedNestedIdResponseObject, error) {
+	if err := s.Service.DeleteNested(ctx, uuid.UUID(request.Id), uuid.UUID(request.NestedId)); err != nil {
+		return nil, err
+	}
+
+	return DeleteItemsIdRelatedNestedId204Response{}, nil
+}
+
+func (s Server) GetItemsIdRelatedNestedIdRelated(ctx context.Context, request GetItemsIdRelatedNestedIdRelatedRequestObject) (GetItemsIdRelatedNestedIdRelatedResponseObject, error) {
+	atoms, err := s.Service.GetAtoms(ctx, uuid.UUID(request.Id), uuid.UUID(request.NestedId))
+	if err != nil {
+		return nil, err
+	}
+
+	return GetItemsIdRelatedNestedIdRelated200JSONResponse(atomsToResponse(atoms)), nil
+}
+
+func (s Server) PostItemsIdRelatedNestedIdRelated(ctx context.Context, request PostItemsIdRelatedNestedIdRelatedRequestObject) (PostItemsIdRelatedNestedIdRelatedResponseObject, error) {
+	atom := domain.Atom{
+		ID:   uuid.UUID(request.Body.Id),
+		Name: request.Body.Name,
+	}
+	if err := s.Service.AddAtom(ctx, uuid.UUID(request.Id), uuid.UUID(request.NestedId), atom); err != nil {
+		return nil, err
+	}
+
+	return PostItemsIdRelatedNestedIdRelated200JSONResponse(atomToResponse(atom)), nil
+}
+
+func (s Server) GetItemsIdRelatedNestedIdRelatedAtomId(ctx context.Context, request GetItemsIdRelatedNestedIdRelatedAtomIdRequestObject) (GetItemsIdRelatedNestedIdRelatedAtomIdResponseObject, error) {
+	atom, err := s.Service.GetAtom(ctx, uuid.UUID(request.Id), uuid.UUID(request.NestedId), uuid.UUID(request.AtomId))
+	if err != nil {
+		return nil, err
+	}
+
+	return GetItemsIdRelatedNestedIdRelatedAtomId200JSONResponse(atomToResponse(atom)), nil
+}
+
+func (s Server) PutItemsIdRelatedNestedIdRelatedAtomId(ctx context.Context, request PutItemsIdRelatedNestedIdRelatedAtomIdRequestObject) (PutItemsIdRelatedNestedIdRelatedAtomIdResponseObject, error) {
+	atom := domain.Atom{
+		ID:   uuid.UUID(request.AtomId),
+		Name: request.Body.Name,
+	}
+	if err := s.Service.UpdateAtom(ctx, uuid.UUID(request.Id), uuid.UUID(request.NestedId), atom); err != nil {
+		return nil, err
+	}
+
+	return PutItemsIdRelatedNestedIdRelatedAtomId200JSONResponse(atomToResponse(atom)), nil
+}
+
+func (s Server) DeleteItemsIdRelatedNestedIdRelatedAtomId(ctx context.Context, request DeleteItemsIdRelatedNestedIdRelatedAtomIdRequestObject) (DeleteItemsIdRelatedNestedIdRelatedAtomIdResponseObject, error) {
+	if err := s.Service.DeleteAtom(ctx, uuid.UUID(request.Id), uuid.UUID(request.NestedId), uuid.UUID(request.AtomId)); err != nil {
+		return nil, err
+	}
+
+	return DeleteItemsIdRelatedNestedIdRelatedAtomId204Response{}, nil
+}
+
+func (s Server) GetRelatedIdItems(ctx context.Context, request GetRelatedIdItemsRequestObject) (GetRelatedIdItemsResponseObject, error) {
+	filter := domain.ItemFilter{ReferencedID: uuid.UUID(request.Id)}
+
+	list, err := s.listItems(ctx, filter, request.Params.Limit, request.Params.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetRelatedIdItems200JSONResponse(list), nil
+}
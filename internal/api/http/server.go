@@ -3,49 +3,189 @@ package http
 import (
 	"context"
 	"crud/internal/domain"
-	"errors"
+	"crud/internal/logging"
 	"github.com/gofrs/uuid/v5"
 	openapitypes "github.com/oapi-codegen/runtime/types"
+	"iter"
 	"log/slog"
+	"time"
 )
 
 type service interface {
 	CreateItem(ctx context.Context, item domain.Item) (uuid.UUID, error)
 	GetItem(ctx context.Context, id uuid.UUID) (domain.Item, bool, error)
-	GetItemsPaginated(ctx context.Context, pagination domain.Pagination) ([]domain.Item, int64, error)
+	GetItemsPaginated(ctx context.Context, filter domain.ItemFilter, pagination domain.Pagination) ([]domain.Item, int64, error)
+	GetItemsByIDs(ctx context.Context, ids []uuid.UUID) ([]domain.ItemResult, error)
 	UpdateItem(ctx context.Context, id uuid.UUID, item domain.Item) error
+	GetChanges(ctx context.Context, since string, limit int) (domain.ChangeSet, error)
+	ExportItems(ctx context.Context, filter domain.ItemFilter, order domain.SortOrder) iter.Seq2[domain.Item, error]
+	ExportRawItems(ctx context.Context, filter domain.ItemFilter) iter.Seq2[domain.Item, error]
+	ImportItems(ctx context.Context, records iter.Seq2[domain.ImportRecord, error], opts domain.ImportOptions) (domain.ImportReport, error)
+
+	GetRelated(ctx context.Context, itemID uuid.UUID) ([]domain.Nested, error)
+	GetNested(ctx context.Context, itemID, nestedID uuid.UUID) (domain.Nested, error)
+	AddNested(ctx context.Context, itemID uuid.UUID, nst domain.Nested) error
+	UpdateNested(ctx context.Context, itemID uuid.UUID, nst domain.Nested) error
+	DeleteNested(ctx context.Context, itemID, nestedID uuid.UUID) error
+	GetAtoms(ctx context.Context, itemID, nestedID uuid.UUID) ([]domain.Atom, error)
+	GetAtom(ctx context.Context, itemID, nestedID, atomID uuid.UUID) (domain.Atom, error)
+	AddAtom(ctx context.Context, itemID, nestedID uuid.UUID, atom domain.Atom) error
+	UpdateAtom(ctx context.Context, itemID, nestedID uuid.UUID, atom domain.Atom) error
+	DeleteAtom(ctx context.Context, itemID, nestedID, atomID uuid.UUID) error
+
+	CreateWebhook(ctx context.Context, w domain.Webhook) (domain.Webhook, error)
+	GetWebhooks(ctx context.Context) ([]domain.Webhook, error)
+	GetWebhook(ctx context.Context, id uuid.UUID) (domain.Webhook, error)
+	DeleteWebhook(ctx context.Context, id uuid.UUID) error
+	GetDeadLetters(ctx context.Context, pagination domain.Pagination) ([]domain.Delivery, error)
+	RetryDeadLetter(ctx context.Context, id uuid.UUID) error
+
+	Subscribe(ctx context.Context, filter domain.EventFilter) <-chan domain.ItemEvent
+	Resume(ctx context.Context, filter domain.EventFilter, after uint64) (<-chan domain.ItemEvent, bool)
 }
 
 type healthChecker interface {
-	HealthCheck(ctx context.Context) bool
+	Health(ctx context.Context) domain.HealthReport
+	Readiness(ctx context.Context) domain.HealthReport
 }
 
 type Server struct {
 	Service service
 	Checker healthChecker
 	Logger  *slog.Logger
+	// Version версия сборки в ответах проверок состояния
+	Version string
+
+	// EventsHeartbeat период комментариев-пульсов в ленте событий
+	EventsHeartbeat time.Duration
+	// ImportBatchSize количество документов, записываемых при импорте одной транзакцией
+	ImportBatchSize int
+	// Stopping закрывается при остановке HTTP сервера. Shutdown не отменяет контексты запросов,
+	// поэтому открытые ленты событий завершаются по нему, а не ждут срока остановки
+	Stopping <-chan struct{}
 }
 
-func (s Server) GetHealth(ctx context.Context, _ GetHealthRequestObject) (GetHealthResponseObject, error) {
-	if ok := s.Checker.HealthCheck(ctx); !ok {
-		return GetHealth503JSONResponse{}, errors.New("not healthy")
+// logger логгер запроса с его ID, вне журнала доступа логгер сервера
+func (s Server) logger(ctx context.Context) *slog.Logger {
+	if logging.RequestID(ctx) == "" {
+		return s.Logger
 	}
 
-	return GetHealth200JSONResponse{}, nil
+	return logging.FromContext(ctx)
 }
 
-func (s Server) GetLive(_ context.Context, _ GetLiveRequestObject) (GetLiveResponseObject, error) {
-	return GetLive200JSONResponse{}, nil
+func (s Server) GetItems(ctx context.Context, request GetItemsRequestObject) (GetItemsResponseObject, error) {
+	params := request.Params
+	if params.Ids != nil {
+		return s.getItemsByIDs(ctx, params)
+	}
+
+	var fields []domain.FieldError
+	if params.Limit == nil {
+		fields = append(fields, domain.FieldError{Field: "limit", Reason: "is required"})
+	}
+	if params.Offset == nil {
+		fields = append(fields, domain.FieldError{Field: "offset", Reason: "is required"})
+	}
+	if len(fields) > 0 {
+		return nil, domain.Validation(fields...)
+	}
+
+	var filter domain.ItemFilter
+	if params.RelatedId != nil {
+		filter.RelatedID = uuid.UUID(*params.RelatedId)
+	}
+	if params.AtomId != nil {
+		filter.AtomID = uuid.UUID(*params.AtomId)
+	}
+
+	list, err := s.listItems(ctx, filter, *params.Limit, *params.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetItems200JSONResponse(list), nil
 }
 
-func (s Server) GetItems(ctx context.Context, request GetItemsRequestObject) (GetItemsResponseObject, error) {
-	items, totalCount, err := s.Service.GetItemsPaginated(ctx, domain.Pagination{
-		Limit:  request.Params.Limit,
-		Offset: request.Params.Offset,
+// getItemsByIDs отвечает на GET /items с ids той же схемой ItemList: найденные документы в порядке запроса
+// и отметка о каждом запрошенном UUID в results
+func (s Server) getItemsByIDs(ctx context.Context, params GetItemsParams) (GetItemsResponseObject, error) {
+	var fields []domain.FieldError
+	for _, p := range []struct {
+		name  string
+		given bool
+	}{
+		{"limit", params.Limit != nil},
+		{"offset", params.Offset != nil},
+		{"related_id", params.RelatedId != nil},
+		{"atom_id", params.AtomId != nil},
+	} {
+		if p.given {
+			fields = append(fields, domain.FieldError{Field: p.name, Reason: "cannot be combined with ids"})
+		}
+	}
+	if len(fields) > 0 {
+		return nil, domain.Validation(fields...)
+	}
+
+	batch, err := s.batchItems(ctx, *params.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	list := ItemList{Items: make([]Item, 0, len(batch.Results)), Results: &batch.Results}
+	for _, res := range batch.Results {
+		if res.Found {
+			list.Items = append(list.Items, *res.Item)
+		}
+	}
+	total := len(list.Items)
+	list.Total = &total
+
+	return GetItems200JSONResponse(list), nil
+}
+
+func (s Server) PostItemsBatch(ctx context.Context, request PostItemsBatchRequestObject) (PostItemsBatchResponseObject, error) {
+	batch, err := s.batchItems(ctx, request.Body.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return PostItemsBatch200JSONResponse(batch), nil
+}
+
+func (s Server) batchItems(ctx context.Context, requested []openapitypes.UUID) (ItemBatch, error) {
+	ids := make([]uuid.UUID, 0, len(requested))
+	for _, id := range requested {
+		ids = append(ids, uuid.UUID(id))
+	}
+
+	results, err := s.Service.GetItemsByIDs(ctx, ids)
+	if err != nil {
+		return ItemBatch{}, err
+	}
+
+	batch := ItemBatch{Results: make([]ItemBatchResult, 0, len(results))}
+	for _, res := range results {
+		result := ItemBatchResult{Id: openapitypes.UUID(res.ID), Found: res.Found}
+		if res.Found {
+			item := itemToResponse(res.Item)
+			result.Item = &item
+		}
+		batch.Results = append(batch.Results, result)
+	}
+
+	return batch, nil
+}
+
+func (s Server) listItems(ctx context.Context, filter domain.ItemFilter, limit, offset int) (ItemList, error) {
+	items, totalCount, err := s.Service.GetItemsPaginated(ctx, filter, domain.Pagination{
+		Limit:  limit,
+		Offset: offset,
 	})
 
 	if err != nil {
-		return GetItems500JSONResponse{}, err
+		return ItemList{}, err
 	}
 
 	res := make([]Item, 0, len(items))
@@ -59,7 +199,7 @@ func (s Server) GetItems(ctx context.Context, request GetItemsRequestObject) (Ge
 	}
 
 	total := int(totalCount)
-	return GetItems200JSONResponse{
+	return ItemList{
 		Items: res,
 		Total: &total,
 	}, nil
@@ -69,8 +209,7 @@ func (s Server) PostItems(ctx context.Context, request PostItemsRequestObject) (
 	itemID, err := s.Service.CreateItem(ctx, createRequestToItem(*request.Body))
 
 	if err != nil {
-		s.Logger.Error(err.Error())
-		return PostItems500JSONResponse{}, err
+		return nil, err
 	}
 
 	return PostItems200JSONResponse{
@@ -81,63 +220,125 @@ func (s Server) PostItems(ctx context.Context, request PostItemsRequestObject) (
 func (s Server) GetItemsId(ctx context.Context, request GetItemsIdRequestObject) (GetItemsIdResponseObject, error) {
 	item, ok, err := s.Service.GetItem(ctx, uuid.UUID(request.Id))
 	if err != nil {
-		s.Logger.Error(err.Error())
-		return GetItemsId500JSONResponse{}, err
+		return nil, err
 	}
 	if !ok {
-		return GetItemsId404JSONResponse{}, err
+		return nil, domain.NotFound("item %s not found", request.Id)
 	}
 
-	nested := make([]Nested, 0, len(item.Related))
-	for _, nst := range item.Related {
-		nested = append(nested, Nested{
-			Id:   openapitypes.UUID(nst.ID),
-			Name: nst.Name,
-		})
-	}
-	return GetItemsId200JSONResponse{
-		Name:      item.Name,
-		CreatedAt: item.CreatedAt,
-		Related:   &nested,
-	}, nil
+	return GetItemsId200JSONResponse(itemToResponse(item)), nil
 }
 
 func (s Server) PutItemsId(ctx context.Context, request PutItemsIdRequestObject) (PutItemsIdResponseObject, error) {
 	err := s.Service.UpdateItem(ctx, uuid.UUID(request.Id), updateRequestToItem(*request.Body))
 	if err != nil {
-		s.Logger.Error(err.Error())
-		return PutItemsId500JSONResponse{}, err
+		return nil, err
 	}
 
 	return PutItemsId200JSONResponse{}, nil
 }
 
 func createRequestToItem(req ItemCreate) domain.Item {
-	nested := make([]domain.Nested, 0, len(*req.Related))
-	for _, nst := range nested {
-		nested = append(nested, domain.Nested{
-			ID:   nst.ID,
-			Name: nst.Name,
-		})
+	var related []Nested
+	if req.Related != nil {
+		related = *req.Related
 	}
 
 	return domain.Item{
 		Name:    req.Name,
-		Related: nested,
+		Related: nestedToDomain(related),
 	}
 }
 
 func updateRequestToItem(req ItemUpdate) domain.Item {
-	nested := make([]domain.Nested, 0, len(req.Related))
-	for _, nst := range nested {
-		nested = append(nested, domain.Nested{
-			ID:   nst.ID,
-			Name: nst.Name,
+	return domain.Item{
+		Name:    req.Name,
+		Related: nestedToDomain(req.Related),
+	}
+}
+
+func nestedToDomain(related []Nested) []domain.Nested {
+	nested := make([]domain.Nested, 0, len(related))
+	for _, nst := range related {
+		nested = append(nested, nestedRequestToDomain(nst))
+	}
+
+	return nested
+}
+
+func nestedRequestToDomain(nst Nested) domain.Nested {
+	var atoms []Atom
+	if nst.Related != nil {
+		atoms = *nst.Related
+	}
+
+	var sort int64
+	if nst.Sort != nil {
+		sort = *nst.Sort
+	}
+
+	return domain.Nested{
+		ID:      uuid.UUID(nst.Id),
+		Name:    nst.Name,
+		Sort:    sort,
+		Related: atomsToDomain(atoms),
+	}
+}
+
+func atomsToDomain(atoms []Atom) []domain.Atom {
+	res := make([]domain.Atom, 0, len(atoms))
+	for _, atom := range atoms {
+		res = append(res, domain.Atom{
+			ID:   uuid.UUID(atom.Id),
+			Name: atom.Name,
 		})
 	}
 
-	return domain.Item{
-		Name:    req.Name,
-		Related: nested,
+	return res
+}
+
+func itemToResponse(item domain.Item) Item {
+	nested := relatedToResponse(item.Related)
+	return Item{
+		Id:        openapitypes.UUID(item.ID),
+		Name:      item.Name,
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+		Related:   &nested,
+	}
+}
+
+func relatedToResponse(related []domain.Nested) []Nested {
+	res := make([]Nested, 0, len(related))
+	for _, nst := range related {
+		res = append(res, nestedToResponse(nst))
+	}
+
+	return res
+}
+
+func nestedToResponse(nst domain.Nested) Nested {
+	atoms := atomsToResponse(nst.Related)
+	return Nested{
+		Id:      openapitypes.UUID(nst.ID),
+		Name:    nst.Name,
+		Sort:    &nst.Sort,
+		Related: &atoms,
+	}
+}
+
+func atomsToResponse(atoms []domain.Atom) []Atom {
+	res := make([]Atom, 0, len(atoms))
+	for _, atom := range atoms {
+		res = append(res, atomToResponse(atom))
+	}
+
+	return res
+}
+
+func atomToResponse(atom domain.Atom) Atom {
+	return Atom{
+		Id:   openapitypes.UUID(atom.ID),
+		Name: atom.Name,
 	}
 }
@@ -0,0 +1,118 @@
+package http
+
+import (
+	"context"
+	"crud/internal/domain"
+	"github.com/gofrs/uuid/v5"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s Server) GetWebhooks(ctx context.Context, _ GetWebhooksRequestObject) (GetWebhooksResponseObject, error) {
+	webhooks, err := s.Service.GetWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Webhook, 0, len(webhooks))
+	for _, w := range webhooks {
+		res = append(res, webhookToResponse(w))
+	}
+
+	return GetWebhooks200JSONResponse{Webhooks: res}, nil
+}
+
+func (s Server) PostWebhooks(ctx context.Context, request PostWebhooksRequestObject) (PostWebhooksResponseObject, error) {
+	w := domain.Webhook{
+		URL:    request.Body.Url,
+		Secret: request.Body.Secret,
+	}
+	if request.Body.Events != nil {
+		for _, e := range *request.Body.Events {
+			w.Events = append(w.Events, domain.EventType(e))
+		}
+	}
+
+	created, err := s.Service.CreateWebhook(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return PostWebhooks200JSONResponse(webhookToResponse(created)), nil
+}
+
+func (s Server) GetWebhooksId(ctx context.Context, request GetWebhooksIdRequestObject) (GetWebhooksIdResponseObject, error) {
+	w, err := s.Service.GetWebhook(ctx, uuid.UUID(request.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	return GetWebhooksId200JSONResponse(webhookToResponse(w)), nil
+}
+
+func (s Server) DeleteWebhooksId(ctx context.Context, request DeleteWebhooksIdRequestObject) (DeleteWebhooksIdResponseObject, error) {
+	if err := s.Service.DeleteWebhook(ctx, uuid.UUID(request.Id)); err != nil {
+		return nil, err
+	}
+
+	return DeleteWebhooksId204Response{}, nil
+}
+
+func (s Server) GetWebhooksDeadLetters(ctx context.Context, request GetWebhooksDeadLettersRequestObject) (GetWebhooksDeadLettersResponseObject, error) {
+	deliveries, err := s.Service.GetDeadLetters(ctx, domain.Pagination{
+		Limit:  request.Params.Limit,
+		Offset: request.Params.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Delivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		res = append(res, deliveryToResponse(d))
+	}
+
+	return GetWebhooksDeadLetters200JSONResponse{Deliveries: res}, nil
+}
+
+func (s Server) PostWebhooksDeadLettersIdRetry(ctx context.Context, request PostWebhooksDeadLettersIdRetryRequestObject) (PostWebhooksDeadLettersIdRetryResponseObject, error) {
+	if err := s.Service.RetryDeadLetter(ctx, uuid.UUID(request.Id)); err != nil {
+		return nil, err
+	}
+
+	return PostWebhooksDeadLettersIdRetry204Response{}, nil
+}
+
+// webhookToResponse не раскрывает секрет подписки
+func webhookToResponse(w domain.Webhook) Webhook {
+	events := make([]ItemEventType, 0, len(w.Events))
+	for _, e := range w.Events {
+		events = append(events, ItemEventType(e))
+	}
+
+	return Webhook{
+		Id:        openapitypes.UUID(w.ID),
+		Url:       w.URL,
+		Events:    events,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func deliveryToResponse(d domain.Delivery) Delivery {
+	res := Delivery{
+		Id:        openapitypes.UUID(d.ID),
+		WebhookId: openapitypes.UUID(d.WebhookID),
+		Event: WebhookPayload{
+			Id:     openapitypes.UUID(d.Event.ID),
+			Type:   ItemEventType(d.Event.Type),
+			ItemId: openapitypes.UUID(d.Event.ItemID),
+			Time:   d.Event.At,
+		},
+		Attempts:      d.Attempts,
+		NextAttemptAt: d.NextAttemptAt,
+	}
+	if d.LastError != "" {
+		res.LastError = &d.LastError
+	}
+
+	return res
+}
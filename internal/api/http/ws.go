@@ -0,0 +1,314 @@
+package http
+
+import (
+	"context"
+	"crud/internal/domain"
+	"crud/internal/logging"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/gofrs/uuid/v5"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Протокол подписок через WebSocket. Клиент отправляет сообщения
+//
+//	{"type": "subscribe", "ids": ["<uuid>", ...]}
+//	{"type": "unsubscribe", "ids": ["<uuid>", ...]}
+//
+// и получает текущую версию документа сразу после подписки (event "snapshot") и при каждом изменении
+// (event "created" или "updated") в виде {"type": "item", "event": ..., "item": {...}}, а при удалении
+// {"type": "deleted", "id": "<uuid>"}. Ошибки в сообщениях клиента не разрывают соединение и возвращаются
+// как {"type": "error", "detail": ...}
+
+const (
+	wsMessageSubscribe   = "subscribe"
+	wsMessageUnsubscribe = "unsubscribe"
+	wsMessageItem        = "item"
+	wsMessageDeleted     = "deleted"
+	wsMessageError       = "error"
+
+	wsEventSnapshot = "snapshot"
+)
+
+type wsRequest struct {
+	Type string      `json:"type"`
+	IDs  []uuid.UUID `json:"ids"`
+}
+
+type wsMessage struct {
+	Type   string             `json:"type"`
+	Event  string             `json:"event,omitempty"`
+	ID     *openapitypes.UUID `json:"id,omitempty"`
+	Item   *Item              `json:"item,omitempty"`
+	Detail string             `json:"detail,omitempty"`
+}
+
+type subscriptionService interface {
+	GetItem(ctx context.Context, id uuid.UUID) (domain.Item, bool, error)
+	Subscribe(ctx context.Context, filter domain.EventFilter) <-chan domain.ItemEvent
+}
+
+// SubscriptionLimits ограничения одного соединения, нулевое значение отключает соответствующую проверку
+type SubscriptionLimits struct {
+	MaxSubscriptions int
+	MaxMessageSize   int64
+	WriteTimeout     time.Duration
+}
+
+// Subscriptions обработчик WebSocket подписок на отдельные документы
+type Subscriptions struct {
+	service subscriptionService
+	logger  *slog.Logger
+	limits  SubscriptionLimits
+
+	mu      sync.Mutex
+	closing bool
+	conns   map[*websocket.Conn]struct{}
+}
+
+func NewSubscriptions(service subscriptionService, logger *slog.Logger, limits SubscriptionLimits) *Subscriptions {
+	return &Subscriptions{
+		service: service,
+		logger:  logger,
+		limits:  limits,
+		conns:   make(map[*websocket.Conn]struct{}),
+	}
+}
+
+func (s *Subscriptions) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := websocket.Accept(w, r, nil)
+	if err != nil {
+		// Accept сам отвечает клиенту на некорректный запрос
+		return
+	}
+	if s.limits.MaxMessageSize > 0 {
+		conn.SetReadLimit(s.limits.MaxMessageSize)
+	}
+
+	if !s.track(conn) {
+		_ = conn.Close(websocket.StatusGoingAway, "server is shutting down")
+		return
+	}
+	defer s.untrack(conn)
+
+	// контекст запроса не отменяется при закрытии перехваченного соединения, поэтому используется отдельный
+	ctx, cancel := context.WithCancel(context.WithoutCancel(r.Context()))
+	defer cancel()
+
+	logger := s.logger
+	if id := logging.RequestID(ctx); id != "" {
+		logger = logger.With("request_id", id)
+	}
+	sess := &wsSession{Subscriptions: s, conn: conn, logger: logger, ids: make(map[uuid.UUID]struct{})}
+	events := s.service.Subscribe(ctx, domain.EventFilter{})
+	go sess.readLoop(ctx, cancel)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				if ctx.Err() == nil {
+					_ = conn.Close(websocket.StatusTryAgainLater, "subscriber fell behind the event stream")
+				}
+				return
+			}
+			if err := sess.notify(ctx, event); err != nil {
+				if err := sess.reject(ctx, err); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Close закрывает все открытые соединения с кодом 1001 и запрещает новые. Вызывается при остановке HTTP сервера
+func (s *Subscriptions) Close() {
+	s.mu.Lock()
+	s.closing = true
+	conns := make([]*websocket.Conn, 0, len(s.conns))
+	for conn := range s.conns {
+		conns = append(conns, conn)
+	}
+	s.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, conn := range conns {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = conn.Close(websocket.StatusGoingAway, "server is shutting down")
+		}()
+	}
+	wg.Wait()
+}
+
+func (s *Subscriptions) track(conn *websocket.Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closing {
+		return false
+	}
+	s.conns[conn] = struct{}{}
+
+	return true
+}
+
+func (s *Subscriptions) untrack(conn *websocket.Conn) {
+	s.mu.Lock()
+	delete(s.conns, conn)
+	s.mu.Unlock()
+
+	_ = conn.CloseNow()
+}
+
+// wsSession состояние одного соединения
+type wsSession struct {
+	*Subscriptions
+	conn *websocket.Conn
+	// logger логгер подписок с ID запроса, открывшего соединение
+	logger *slog.Logger
+
+	mu  sync.Mutex
+	ids map[uuid.UUID]struct{}
+}
+
+func (sess *wsSession) readLoop(ctx context.Context, cancel context.CancelFunc) {
+	defer cancel()
+
+	for {
+		// сообщения больше MaxMessageSize библиотека отклоняет сама, закрывая соединение с кодом 1009
+		_, data, err := sess.conn.Read(ctx)
+		if err != nil {
+			return
+		}
+
+		var req wsRequest
+		if err = json.Unmarshal(data, &req); err != nil {
+			err = domain.Validation(domain.FieldError{Field: "message", Reason: "is malformed"})
+		} else {
+			switch req.Type {
+			case wsMessageSubscribe:
+				err = sess.subscribe(ctx, req.IDs)
+			case wsMessageUnsubscribe:
+				sess.unsubscribe(req.IDs)
+			default:
+				err = domain.Validation(domain.FieldError{Field: "type", Reason: "has invalid value"})
+			}
+		}
+
+		if err != nil {
+			if err := sess.reject(ctx, err); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (sess *wsSession) subscribe(ctx context.Context, ids []uuid.UUID) error {
+	sess.mu.Lock()
+	added := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := sess.ids[id]; !ok {
+			added = append(added, id)
+		}
+	}
+	if limit := sess.limits.MaxSubscriptions; limit > 0 && len(sess.ids)+len(added) > limit {
+		sess.mu.Unlock()
+		return domain.Validation(domain.FieldError{Field: "ids", Reason: fmt.Sprintf("connection may subscribe to at most %d items", limit)})
+	}
+	for _, id := range added {
+		sess.ids[id] = struct{}{}
+	}
+	sess.mu.Unlock()
+
+	for _, id := range added {
+		if err := sess.sendItem(ctx, wsEventSnapshot, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (sess *wsSession) unsubscribe(ids []uuid.UUID) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	for _, id := range ids {
+		delete(sess.ids, id)
+	}
+}
+
+func (sess *wsSession) subscribed(id uuid.UUID) bool {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	_, ok := sess.ids[id]
+	return ok
+}
+
+func (sess *wsSession) notify(ctx context.Context, event domain.ItemEvent) error {
+	if !sess.subscribed(event.ItemID) {
+		return nil
+	}
+
+	if event.Type == domain.EventDeleted {
+		id := openapitypes.UUID(event.ItemID)
+		return sess.write(ctx, wsMessage{Type: wsMessageDeleted, ID: &id})
+	}
+
+	return sess.sendItem(ctx, string(event.Type), event.ItemID)
+}
+
+// sendItem отправляет актуальную версию документа, отсутствующий документ считается удаленным
+func (sess *wsSession) sendItem(ctx context.Context, event string, id uuid.UUID) error {
+	item, found, err := sess.service.GetItem(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		respID := openapitypes.UUID(id)
+		return sess.write(ctx, wsMessage{Type: wsMessageDeleted, ID: &respID})
+	}
+
+	resp := itemToResponse(domain.Transform(item))
+	return sess.write(ctx, wsMessage{Type: wsMessageItem, Event: event, Item: &resp})
+}
+
+// reject сообщает клиенту об ошибке, не относящиеся к предметной области ошибки передаются без подробностей.
+// Возвращает ошибку, только если не удалось записать в соединение
+func (sess *wsSession) reject(ctx context.Context, err error) error {
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		sess.logger.Error(err.Error(), "path", "websocket")
+		return sess.write(ctx, wsMessage{Type: wsMessageError, Detail: "internal server error"})
+	}
+
+	detail := domainErr.Detail
+	for _, f := range domainErr.Fields {
+		detail += fmt.Sprintf("; %s %s", f.Field, f.Reason)
+	}
+
+	return sess.write(ctx, wsMessage{Type: wsMessageError, Detail: detail})
+}
+
+func (sess *wsSession) write(ctx context.Context, msg wsMessage) error {
+	if sess.limits.WriteTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, sess.limits.WriteTimeout)
+		defer cancel()
+	}
+
+	return wsjson.Write(ctx, sess.conn, msg)
+}
@@ -1,16 +1,32 @@
 package app
 
 import (
+	"crud/internal/client"
 	"crud/internal/config"
+	"crud/internal/logging"
+	"crud/internal/metrics"
 	"crud/internal/service"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc"
 	"log/slog"
 	"net/http"
+	"os"
 )
 
+// Version версия сборки, задается при сборке через -ldflags "-X crud/internal/app.Version=..."
+var Version = "dev"
+
 type App struct {
-	Srv           service.Service
-	Server        *http.Server
+	Srv    service.Service
+	DB     *client.Client
+	Server *http.Server
+	// AdminServer служебный сервер с метриками, nil если выключен
+	AdminServer   *http.Server
+	Metrics       *metrics.Metrics
+	Tracing       *sdktrace.TracerProvider
+	GRPCServer    *grpc.Server
 	HealthChecker service.Checker
+	Dispatcher    *service.Dispatcher
 	Config        *config.Config
 	logger        slog.Logger
 }
@@ -24,3 +40,16 @@ func New() *App {
 		logger: *logger,
 	}
 }
+
+// setupLogger настраивает уровень и формат логов по загруженной конфигурации. Логи пишутся в stderr,
+// чтобы не смешиваться с выводом команд
+func (app *App) setupLogger() error {
+	logger, err := logging.New(app.Config.Log, os.Stderr)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(logger)
+	app.logger = *logger
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"context"
+	"crud/internal/client"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// runBackup сохраняет пространство имен из конфигурации в архив:
+//
+//	backup <file>
+//
+// Архив сначала пишется во временный файл рядом с целевым и переименовывается после успешного завершения
+func runBackup(ctx context.Context, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("backup", flag.ContinueOnError)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("backup: exactly one file is expected")
+	}
+	path := flags.Arg(0)
+
+	_, db, err := startClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Stop(ctx)
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".backup-*")
+	if err != nil {
+		return fmt.Errorf("backup: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	info, err := db.Backup(ctx, tmp)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("backup: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("backup: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(stdout, "namespace %s: %d documents, sha256 %s\n", info.Namespace, info.Documents, info.Checksum)
+	return nil
+}
+
+// runRestore восстанавливает архив:
+//
+//	restore [-namespace name] [-truncate] [-verify] <file>
+//
+// По умолчанию в пространство имен из конфигурации. С -verify архив только проверяется
+func runRestore(ctx context.Context, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("restore", flag.ContinueOnError)
+	namespace := flags.String("namespace", "", "target namespace, by default taken from the config")
+	truncate := flags.Bool("truncate", false, "delete documents of a non-empty target namespace")
+	verify := flags.Bool("verify", false, "only check the archive integrity")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("restore: exactly one file is expected")
+	}
+
+	archive, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return fmt.Errorf("restore: %w", err)
+	}
+	defer archive.Close()
+
+	if *verify {
+		info, err := client.VerifyBackup(archive)
+		if err != nil {
+			return fmt.Errorf("restore: %w", err)
+		}
+		_, _ = fmt.Fprintf(stdout, "namespace %s at %s: %d documents, archive is intact\n",
+			info.Namespace, info.CreatedAt.Format(time.RFC3339), info.Documents)
+		return nil
+	}
+
+	app, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	cfg := app.Config.DB
+	if *namespace != "" {
+		cfg.Namespace = *namespace
+	}
+	db := client.New(cfg)
+	defer db.Stop(ctx)
+
+	info, err := db.Restore(ctx, archive, client.RestoreOptions{
+		Truncate:  *truncate,
+		BatchSize: app.Config.Import.BatchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("restore: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(stdout, "namespace %s restored into %s: %d documents\n", info.Namespace, cfg.Namespace, info.Documents)
+	return nil
+}
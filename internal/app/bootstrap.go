@@ -1,32 +1,138 @@
 package app
 
 import (
+	"context"
+	grpcapi "crud/internal/api/grpc"
 	api "crud/internal/api/http"
 	"crud/internal/client"
+	"crud/internal/config"
+	"crud/internal/domain"
+	"crud/internal/logging"
+	"crud/internal/metrics"
 	"crud/internal/service"
+	"crud/internal/tracing"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"net"
 	"net/http"
+	"sync"
 )
 
 func (app *App) Bootstrap() {
+	app.Metrics = metrics.New()
+	app.Tracing = app.newTracing()
+
 	db := client.New(app.Config.DB)
-	srv := service.New(db, app.Config.TTL)
-	checker := service.NewChecker(db)
+	db.SetObserver(app.Metrics)
+	db.SetTracerProvider(app.Tracing)
+	srv := service.New(db, app.Config.TTL, domain.Limits{
+		MaxRelated:    app.Config.Validation.MaxRelated,
+		MaxAtoms:      app.Config.Validation.MaxAtoms,
+		MaxNameLength: app.Config.Validation.MaxNameLength,
+		MaxBatchSize:  app.Config.Validation.MaxBatchSize,
+	}, app.Config.Events.ReplaySize)
+	srv.SetObserver(app.Metrics)
+	srv.SetTracerProvider(app.Tracing)
+	app.Metrics.RegisterCache(srv.CacheStats)
+	checker := service.NewChecker(app.Config.Health.Timeout,
+		service.HealthCheck{Name: "reindexer", Check: db.CheckConnection},
+		service.HealthCheck{Name: "namespace", Check: db.CheckNamespace},
+		service.HealthCheck{Name: "indexes", Check: db.CheckIndexes},
+		service.HealthCheck{Name: "migrations", Check: db.CheckMigrations},
+		service.HealthCheck{Name: "cache", Check: srv.CheckCache},
+	)
+	app.Dispatcher = service.NewDispatcher(db, &http.Client{Timeout: app.Config.Webhooks.Timeout}, service.DispatcherOptions{
+		PollInterval: app.Config.Webhooks.PollInterval,
+		BatchSize:    app.Config.Webhooks.BatchSize,
+		Concurrency:  app.Config.Webhooks.Concurrency,
+		MaxAttempts:  app.Config.Webhooks.MaxAttempts,
+		BackoffBase:  app.Config.Webhooks.BackoffBase,
+		BackoffMax:   app.Config.Webhooks.BackoffMax,
+		Lease:        app.Config.Webhooks.Lease,
+	}, app.logger.With("worker", "webhooks"))
 
 	app.Srv = *srv
+	app.DB = db
 	app.HealthChecker = *checker
 
-	server := api.NewStrictHandler(api.Server{
+	stopping := make(chan struct{})
+	apiServer := api.Server{
 		Service: app.Srv,
 		Checker: app.HealthChecker,
 		Logger:  app.logger.With("api", "http"),
-	}, nil)
+		Version: Version,
+
+		EventsHeartbeat: app.Config.Events.Heartbeat,
+		ImportBatchSize: app.Config.Import.BatchSize,
+		Stopping:        stopping,
+	}
+	server := api.NewStrictHandlerWithOptions(apiServer, []api.StrictMiddlewareFunc{api.OperationMiddleware}, api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  apiServer.HandleRequestError,
+		ResponseErrorHandlerFunc: apiServer.HandleResponseError,
+	})
 
 	r := http.NewServeMux()
 
-	h := api.HandlerFromMux(server, r)
+	subscriptions := api.NewSubscriptions(app.Srv, app.logger.With("api", "websocket"), api.SubscriptionLimits{
+		MaxSubscriptions: app.Config.WebSocket.MaxSubscriptions,
+		MaxMessageSize:   app.Config.WebSocket.MaxMessageSize,
+		WriteTimeout:     app.Config.WebSocket.WriteTimeout,
+	})
+	r.Handle("GET /items/ws", subscriptions)
+
+	h := api.HandlerWithOptions(server, api.StdHTTPServerOptions{
+		BaseRouter:       r,
+		ErrorHandlerFunc: apiServer.HandleRequestError,
+	})
+	// снаружи внутрь: метрики, трассировка, журнал доступа, перехват паник. Журнал внутри трассировки,
+	// чтобы знать trace_id, а перехват внутри журнала, чтобы в журнал попал ответ 500 с ID запроса
+	var handler http.Handler = logging.Middleware(app.logger.With("api", "http"))(apiServer.Recover(h))
+	handler = otelhttp.NewHandler(handler, "http.server",
+		otelhttp.WithTracerProvider(app.Tracing),
+		otelhttp.WithPropagators(tracing.Propagator),
+		// имя span уточняется до операции OpenAPI в OperationMiddleware
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string { return r.Method }),
+	)
 	app.Server = &http.Server{
-		Handler: h,
+		Handler: app.Metrics.Middleware(handler),
 		Addr:    net.JoinHostPort(app.Config.Server.Host, app.Config.Server.Port),
 	}
+	// Shutdown не закрывает перехваченные соединения и не отменяет запросы: WebSocket подписчики
+	// и ленты событий закрываются отдельно
+	app.Server.RegisterOnShutdown(subscriptions.Close)
+	app.Server.RegisterOnShutdown(sync.OnceFunc(func() { close(stopping) }))
+
+	if app.Config.Admin.Enabled {
+		admin := http.NewServeMux()
+		admin.Handle("GET /metrics", app.Metrics.Handler())
+		app.AdminServer = &http.Server{
+			Handler: admin,
+			Addr:    net.JoinHostPort(app.Config.Admin.Host, app.Config.Admin.Port),
+		}
+	}
+
+	grpcServer := grpcapi.Server{
+		Service: app.Srv,
+		Logger:  app.logger.With("api", "grpc"),
+	}
+	app.GRPCServer = grpc.NewServer(
+		grpc.UnaryInterceptor(grpcServer.UnaryErrorInterceptor),
+		grpc.StreamInterceptor(grpcServer.StreamErrorInterceptor),
+	)
+	grpcapi.RegisterItemServiceServer(app.GRPCServer, grpcServer)
+	grpc_health_v1.RegisterHealthServer(app.GRPCServer, grpcapi.HealthServer{Checker: app.HealthChecker})
+}
+
+// newTracing создает провайдер трассировки. Ошибка конфигурации экспортера не мешает запуску:
+// трассировка остается без экспорта
+func (app *App) newTracing() *sdktrace.TracerProvider {
+	provider, err := tracing.New(context.Background(), app.Config.Tracing, Version)
+	if err != nil {
+		app.logger.Error("tracing export is disabled", "error", err)
+		provider, _ = tracing.New(context.Background(), config.TracingConfig{SampleRatio: app.Config.Tracing.SampleRatio}, Version)
+	}
+
+	return provider
 }
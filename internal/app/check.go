@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"crud/internal/client"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+)
+
+// runCheck проверяет доступность Reindexer, наличие пространства имен документов и расхождение его индексов
+// с конфигурацией, ничего не меняя:
+//
+//	check
+func runCheck(ctx context.Context, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("check", flag.ContinueOnError)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	app, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	cfg := app.Config.DB
+	db := client.New(cfg)
+	defer db.Stop(ctx)
+
+	addr := net.JoinHostPort(cfg.Host, cfg.Port)
+	if !db.IsConnected(ctx) {
+		return fmt.Errorf("check: reindexer at %s/%s is unreachable", addr, cfg.Name)
+	}
+	_, _ = fmt.Fprintf(stdout, "reindexer %s/%s: ok\n", addr, cfg.Name)
+
+	info, err := db.Inspect(ctx)
+	if err != nil {
+		return fmt.Errorf("check: %w", err)
+	}
+	if !info.Exists {
+		return errors.New("check: namespace " + info.Name + " does not exist")
+	}
+	_, _ = fmt.Fprintf(stdout, "namespace %s: %d documents, indexes %s\n", info.Name, info.Documents, strings.Join(info.Indexes, ", "))
+
+	report, err := db.ReconcileIndexes(ctx, true)
+	if err != nil {
+		return fmt.Errorf("check: %w", err)
+	}
+	printIndexDrift(stdout, "missing", report.Added)
+	printIndexDrift(stdout, "changed", report.Updated)
+	printIndexDrift(stdout, "to drop", report.Dropped)
+	printIndexDrift(stdout, "undeclared", report.Unknown)
+	if !report.Changed() && len(report.Unknown) == 0 {
+		_, _ = fmt.Fprintln(stdout, "indexes match the config")
+	}
+
+	return nil
+}
+
+func printIndexDrift(w io.Writer, kind string, names []string) {
+	if len(names) > 0 {
+		_, _ = fmt.Fprintf(w, "indexes %s: %s\n", kind, strings.Join(names, ", "))
+	}
+}
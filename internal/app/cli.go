@@ -0,0 +1,128 @@
+package app
+
+import (
+	"context"
+	"crud/internal/client"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+const usage = `usage: crud <command> [flags] [args]
+
+commands:
+  serve                  run HTTP and gRPC servers, the default
+  check                  check Reindexer connectivity and the items namespace
+  seed                   insert synthetic items
+  count                  count items
+  get <id>               print an item
+  delete <id>...         delete items
+  import <file|->        import items from NDJSON or CSV
+  backup <file>          dump the items namespace into an archive
+  restore <file>         restore the items namespace from an archive
+  migrate up|down|status apply, roll back or list migrations
+
+Run "crud <command> -h" for command flags.
+`
+
+// Run выполняет команду из аргументов командной строки. Без команды запускается сервер
+func Run(ctx context.Context, args []string) error {
+	err := runCommand(ctx, args)
+	if errors.Is(err, flag.ErrHelp) {
+		// справка по флагам уже напечатана
+		return nil
+	}
+
+	return err
+}
+
+func runCommand(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return Start(ctx)
+	}
+
+	cmd, args := args[0], args[1:]
+	switch cmd {
+	case "serve":
+		return Start(ctx)
+	case "check":
+		return runCheck(ctx, args, os.Stdout)
+	case "seed":
+		return runSeed(ctx, args, os.Stdout)
+	case "count":
+		return runCount(ctx, args, os.Stdout)
+	case "get":
+		return runGet(ctx, args, os.Stdout)
+	case "delete":
+		return runDelete(ctx, args, os.Stdout)
+	case "migrate":
+		return runMigrate(ctx, args, os.Stdout)
+	case "import":
+		return runImport(ctx, args, os.Stdin, os.Stdout)
+	case "backup":
+		return runBackup(ctx, args, os.Stdout)
+	case "restore":
+		return runRestore(ctx, args, os.Stdout)
+	case "help", "-h", "-help", "--help":
+		_, _ = fmt.Fprint(os.Stdout, usage)
+		return nil
+	default:
+		_, _ = fmt.Fprint(os.Stderr, usage)
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+}
+
+// loadConfig создает приложение с загруженной конфигурацией
+func loadConfig() (*App, error) {
+	app := New()
+	if err := app.Config.Load(); err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+	if err := app.setupLogger(); err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+
+	return app, nil
+}
+
+// startService загружает конфигурацию и подключается к базе для команд, которым не нужен сервер
+func startService(ctx context.Context) (*App, error) {
+	app, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	app.Bootstrap()
+
+	if err := app.Srv.Start(ctx); err != nil {
+		return nil, fmt.Errorf("start service: %w", err)
+	}
+
+	return app, nil
+}
+
+// startClient загружает конфигурацию и подключается к базе напрямую, без кеша и событий сервиса
+func startClient(ctx context.Context) (*App, *client.Client, error) {
+	app, err := loadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db := client.New(app.Config.DB)
+	if err := db.Start(ctx); err != nil {
+		return nil, nil, fmt.Errorf("start client: %w", err)
+	}
+
+	return app, db, nil
+}
+
+// openInput открывает файл, "-" означает стандартный ввод
+func openInput(path string, stdin io.Reader) (io.ReadCloser, error) {
+	if path == "-" {
+		return io.NopCloser(stdin), nil
+	}
+
+	return os.Open(path)
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"context"
+	"crud/internal/domain"
+	"crud/internal/transfer"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"iter"
+	"path/filepath"
+	"strings"
+)
+
+// runImport импортирует документы из файла:
+//
+//	import [-format ndjson|csv] [-policy skip|overwrite|fail] [-dry-run] [-batch-size n] <file|->
+//
+// Формат по умолчанию определяется по расширению файла. Печатает результат по каждой записи,
+// кроме созданных, и итог. Завершается ошибкой, если есть отклоненные записи
+func runImport(ctx context.Context, args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	format := flags.String("format", "", "file format: ndjson or csv, by default taken from the file extension")
+	policy := flags.String("policy", string(domain.ConflictFail), "conflict policy: skip, overwrite or fail")
+	dryRun := flags.Bool("dry-run", false, "validate records without writing them")
+	batchSize := flags.Int("batch-size", 0, "documents per transaction, by default taken from the config")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("import: exactly one file is expected, use - for stdin")
+	}
+	path := flags.Arg(0)
+
+	if *format == "" {
+		*format = "ndjson"
+		if strings.EqualFold(filepath.Ext(path), ".csv") {
+			*format = "csv"
+		}
+	}
+
+	input, err := openInput(path, stdin)
+	if err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+	defer input.Close()
+
+	var records iter.Seq2[domain.ImportRecord, error]
+	switch *format {
+	case "ndjson":
+		records = transfer.DecodeNDJSON(input)
+	case "csv":
+		records = transfer.DecodeCSV(input)
+	default:
+		return fmt.Errorf("import: unknown format %q", *format)
+	}
+
+	app, err := startService(ctx)
+	if err != nil {
+		return err
+	}
+	defer app.Srv.Close(ctx)
+
+	opts := domain.ImportOptions{
+		Policy:    domain.ConflictPolicy(*policy),
+		DryRun:    *dryRun,
+		BatchSize: app.Config.Import.BatchSize,
+	}
+	if *batchSize > 0 {
+		opts.BatchSize = *batchSize
+	}
+
+	report, err := app.Srv.ImportItems(ctx, records, opts)
+	// отчет печатается и при ошибке, чтобы было видно, какие порции уже записаны
+	printImportReport(stdout, report)
+	if err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+	if report.Aborted || report.Rejected > 0 {
+		return fmt.Errorf("import: %d records rejected", report.Rejected)
+	}
+
+	return nil
+}
+
+func printImportReport(w io.Writer, report domain.ImportReport) {
+	for _, line := range report.Lines {
+		if line.Status == domain.ImportCreated {
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "line %d\t%s\t%s\t%s\n", line.Line, line.Status, line.ID, line.Reason)
+	}
+
+	summary := fmt.Sprintf("accepted %d, skipped %d, rejected %d", report.Accepted, report.Skipped, report.Rejected)
+	if report.DryRun {
+		summary += " (dry run, nothing written)"
+	}
+	if report.Aborted {
+		summary += " (aborted by conflict)"
+	}
+	_, _ = fmt.Fprintln(w, summary)
+}
@@ -0,0 +1,23 @@
+package app
+
+import (
+	"context"
+	"fmt"
+)
+
+// reconcileIndexes приводит индексы к объявленным в конфигурации при запуске сервера
+func (app *App) reconcileIndexes(ctx context.Context) error {
+	report, err := app.DB.ReconcileIndexes(ctx, false)
+	if err != nil {
+		return fmt.Errorf("reconcile indexes: %w", err)
+	}
+
+	if report.Changed() {
+		app.logger.Info("indexes reconciled", "added", report.Added, "updated", report.Updated, "dropped", report.Dropped)
+	}
+	if len(report.Unknown) > 0 {
+		app.logger.Warn("namespace has undeclared indexes, set drop_unknown_indexes to drop them", "indexes", report.Unknown)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package app
+
+import (
+	"context"
+	"crud/internal/domain"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/gofrs/uuid/v5"
+	"io"
+)
+
+// runCount печатает количество документов, подходящих под отбор:
+//
+//	count [-related-id id] [-atom-id id]
+func runCount(ctx context.Context, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("count", flag.ContinueOnError)
+	var filter domain.ItemFilter
+	flags.TextVar(&filter.RelatedID, "related-id", uuid.Nil, "only items with this nested document")
+	flags.TextVar(&filter.AtomID, "atom-id", uuid.Nil, "only items with this atom")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	_, db, err := startClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Stop(ctx)
+
+	count, err := db.GetItemsCount(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("count: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(stdout, count)
+	return nil
+}
+
+// runGet печатает документ в JSON в том виде, в каком его отдает сервис:
+//
+//	get <id>
+func runGet(ctx context.Context, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("get", flag.ContinueOnError)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("get: exactly one id is expected")
+	}
+	id, err := uuid.FromString(flags.Arg(0))
+	if err != nil {
+		return fmt.Errorf("get: %w", err)
+	}
+
+	app, err := startService(ctx)
+	if err != nil {
+		return err
+	}
+	defer app.Srv.Close(ctx)
+
+	item, found, err := app.Srv.GetItem(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("get: item %s not found", id)
+	}
+
+	encoder := json.NewEncoder(stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(item)
+}
+
+// runDelete удаляет документы через сервис, поэтому сбрасывается кеш и публикуются события:
+//
+//	delete <id>...
+//
+// Ошибка по одному документу не останавливает удаление остальных
+func runDelete(ctx context.Context, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("delete", flag.ContinueOnError)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		return errors.New("delete: at least one id is expected")
+	}
+	ids := make([]uuid.UUID, 0, flags.NArg())
+	for _, arg := range flags.Args() {
+		id, err := uuid.FromString(arg)
+		if err != nil {
+			return fmt.Errorf("delete: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	app, err := startService(ctx)
+	if err != nil {
+		return err
+	}
+	defer app.Srv.Close(ctx)
+
+	failed := 0
+	for _, id := range ids {
+		if err := app.Srv.DeleteItem(ctx, id); err != nil {
+			failed++
+			_, _ = fmt.Fprintf(stdout, "%s\t%s\n", id, domain.Reason(err))
+			continue
+		}
+		_, _ = fmt.Fprintf(stdout, "%s\tdeleted\n", id)
+	}
+	if failed > 0 {
+		return fmt.Errorf("delete: %d of %d items not deleted", failed, len(ids))
+	}
+
+	return nil
+}
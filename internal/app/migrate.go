@@ -0,0 +1,104 @@
+package app
+
+import (
+	"context"
+	"crud/internal/client"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+)
+
+// migrate применяет недостающие миграции при запуске сервера
+func (app *App) migrate(ctx context.Context) error {
+	done, err := app.DB.MigrateUp(ctx, app.migrateOptions())
+	for _, m := range done {
+		app.logger.Info("migration applied", "version", m.Version, "name", m.Name)
+	}
+	if err != nil {
+		return fmt.Errorf("migrate: %w", err)
+	}
+
+	return nil
+}
+
+func (app *App) migrateOptions() client.MigrateOptions {
+	return client.MigrateOptions{
+		LockTTL:  app.Config.Migrations.LockTTL,
+		LockWait: app.Config.Migrations.LockWait,
+	}
+}
+
+// runMigrate управляет миграциями:
+//
+//	migrate up
+//	migrate down [-steps n]
+//	migrate status
+func runMigrate(ctx context.Context, args []string, stdout io.Writer) error {
+	if len(args) == 0 {
+		return errors.New("migrate: up, down or status is expected")
+	}
+	action, args := args[0], args[1:]
+
+	flags := flag.NewFlagSet("migrate "+action, flag.ContinueOnError)
+	steps := 0
+	if action == "down" {
+		flags.IntVar(&steps, "steps", 1, "number of migrations to roll back")
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 0 {
+		return fmt.Errorf("migrate %s: unexpected arguments %v", action, flags.Args())
+	}
+
+	app, db, err := startClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Stop(ctx)
+
+	var done []client.MigrationState
+	switch action {
+	case "up":
+		done, err = db.MigrateUp(ctx, app.migrateOptions())
+	case "down":
+		done, err = db.MigrateDown(ctx, steps, app.migrateOptions())
+	case "status":
+		states, err := db.MigrationStatus(ctx)
+		if err != nil {
+			return fmt.Errorf("migrate status: %w", err)
+		}
+		printMigrations(stdout, states)
+		return nil
+	default:
+		return fmt.Errorf("migrate: unknown action %q", action)
+	}
+
+	// выполненные миграции печатаются и при ошибке следующей
+	for _, m := range done {
+		_, _ = fmt.Fprintf(stdout, "%s\t%d\t%s\n", action, m.Version, m.Name)
+	}
+	if err != nil {
+		return fmt.Errorf("migrate %s: %w", action, err)
+	}
+	if len(done) == 0 {
+		_, _ = fmt.Fprintln(stdout, "nothing to migrate")
+	}
+
+	return nil
+}
+
+func printMigrations(w io.Writer, states []client.MigrationState) {
+	for _, m := range states {
+		status := "pending"
+		if m.Applied {
+			status = "applied " + m.AppliedAt.Format(time.RFC3339)
+		}
+		if m.Unknown {
+			status += ", unknown to this build"
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\n", m.Version, m.Name, status)
+	}
+}
@@ -0,0 +1,80 @@
+package app
+
+import (
+	"context"
+	"crud/internal/domain"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/gofrs/uuid/v5"
+	"io"
+	"iter"
+)
+
+// runSeed добавляет синтетические документы через сервис, поэтому они проходят проверку и порождают события:
+//
+//	seed [-count n] [-related n] [-atoms n]
+func runSeed(ctx context.Context, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	count := flags.Int("count", 10, "number of items")
+	related := flags.Int("related", 2, "nested documents per item")
+	atoms := flags.Int("atoms", 2, "atoms per nested document")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *count <= 0 || *related < 0 || *atoms < 0 {
+		return errors.New("seed: count must be positive, related and atoms must not be negative")
+	}
+
+	app, err := startService(ctx)
+	if err != nil {
+		return err
+	}
+	defer app.Srv.Close(ctx)
+
+	report, err := app.Srv.ImportItems(ctx, seedItems(*count, *related, *atoms), domain.ImportOptions{
+		Policy:    domain.ConflictFail,
+		BatchSize: app.Config.Import.BatchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("seed: %w", err)
+	}
+	if report.Rejected > 0 {
+		printImportReport(stdout, report)
+		return fmt.Errorf("seed: %d items rejected", report.Rejected)
+	}
+
+	_, _ = fmt.Fprintf(stdout, "seeded %d items\n", report.Accepted)
+	return nil
+}
+
+// seedItems порождает документы с новыми ID, номер записи используется в именах и сортировке
+func seedItems(count, related, atoms int) iter.Seq2[domain.ImportRecord, error] {
+	return func(yield func(domain.ImportRecord, error) bool) {
+		for i := 1; i <= count; i++ {
+			item := domain.Item{
+				ID:   uuid.Must(uuid.NewV4()),
+				Name: fmt.Sprintf("Seed item %d", i),
+				Sort: int64(i),
+			}
+			for j := 1; j <= related; j++ {
+				nested := domain.Nested{
+					ID:   uuid.Must(uuid.NewV4()),
+					Name: fmt.Sprintf("Seed nested %d.%d", i, j),
+					Sort: int64(j),
+				}
+				for k := 1; k <= atoms; k++ {
+					nested.Related = append(nested.Related, domain.Atom{
+						ID:   uuid.Must(uuid.NewV4()),
+						Name: fmt.Sprintf("Seed atom %d.%d.%d", i, j, k),
+					})
+				}
+				item.Related = append(item.Related, nested)
+			}
+
+			if !yield(domain.ImportRecord{Line: i, Item: item}, nil) {
+				return
+			}
+		}
+	}
+}
@@ -3,22 +3,102 @@ package app
 import (
 	"context"
 	"fmt"
+	"google.golang.org/grpc"
+	"net/http"
 	"time"
 )
 
-const shutdownDuration = 5 * time.Second
+const (
+	// defaultShutdownTimeout срок остановки, если он не задан в конфигурации
+	defaultShutdownTimeout = 15 * time.Second
+	// releaseTimeout срок на закрытие соединения с Reindexer и отправку spans. Он отсчитывается отдельно,
+	// чтобы ресурсы освобождались, даже если серверы не уложились в Shutdown.Timeout
+	releaseTimeout = 5 * time.Second
+)
+
+// drain снимает готовность и ждет DrainDelay, продолжая обслуживать запросы, пока балансировщик
+// не перестанет направлять их на этот экземпляр
+func (app *App) drain() {
+	app.HealthChecker.SetReady(false)
+	if app.Config.Shutdown.DrainDelay <= 0 {
+		return
+	}
 
+	app.logger.Info("draining", "delay", app.Config.Shutdown.DrainDelay)
+	time.Sleep(app.Config.Shutdown.DrainDelay)
+}
+
+// Shutdown останавливает приложение по порядку: HTTP и gRPC серверы, фоновые обработчики, кеш и соединение
+// с Reindexer, затем отправляет оставшиеся spans. Серверы и обработчики укладываются в общий срок
+// Shutdown.Timeout и возвращаются сразу, как только остановились
 func (app *App) Shutdown() {
-	ctxWithTimeout, cancel := context.WithTimeout(context.Background(), shutdownDuration)
+	timeout := app.Config.Shutdown.Timeout
+	if timeout <= 0 {
+		timeout = defaultShutdownTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	start := time.Now()
+	app.HealthChecker.SetReady(false)
+
 	if app.Server != nil {
-		if err := app.Server.Shutdown(ctxWithTimeout); err != nil {
-			app.logger.Error(fmt.Errorf("Server.Shutdown: %w", err).Error())
+		app.stopHTTP(ctx, "Server", app.Server)
+	}
+	if app.GRPCServer != nil {
+		stopGRPC(ctx, app.GRPCServer)
+	}
+
+	if app.Dispatcher != nil {
+		app.Dispatcher.Stop(ctx)
+	}
+
+	// метрики доступны, пока останавливаются остальные серверы и обработчики
+	if app.AdminServer != nil {
+		app.stopHTTP(ctx, "AdminServer", app.AdminServer)
+	}
+	timedOut := ctx.Err() != nil
+
+	releaseCtx, cancelRelease := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancelRelease()
+
+	// кеш и соединение с Reindexer
+	app.Srv.Close(releaseCtx)
+
+	// оставшиеся spans отправляются после остановки серверов, чтобы попали и последние запросы
+	if app.Tracing != nil {
+		if err := app.Tracing.Shutdown(releaseCtx); err != nil {
+			app.logger.Error(fmt.Errorf("Tracing.Shutdown: %w", err).Error())
 		}
 	}
 
-	app.Srv.Close(ctxWithTimeout)
+	if timedOut {
+		app.logger.Warn("shutdown timed out, remaining requests were interrupted", "timeout", timeout)
+		return
+	}
+	app.logger.Info("shutdown completed", "duration", time.Since(start))
+}
+
+// stopHTTP дожидается завершения активных запросов, но не дольше ctx. Ленты событий закрываются сразу
+// по сигналу остановки, выгрузки, не успевшие завершиться, обрываются закрытием соединений
+func (app *App) stopHTTP(ctx context.Context, name string, srv *http.Server) {
+	if err := srv.Shutdown(ctx); err != nil {
+		app.logger.Error(fmt.Errorf("%s.Shutdown: %w", name, err).Error())
+		_ = srv.Close()
+	}
+}
+
+// stopGRPC дожидается завершения активных вызовов, но не дольше ctx: открытые потоки Watch сами не завершаются
+func stopGRPC(ctx context.Context, srv *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
 
-	<-ctxWithTimeout.Done()
-	app.logger.Info(fmt.Sprintf("Shutdown timeout of %v", shutdownDuration))
-	cancel()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		srv.Stop()
+	}
 }
@@ -3,24 +3,146 @@ package app
 import (
 	"context"
 	"fmt"
+	"net"
+	"time"
 )
 
+// Start запускает сервер. HTTP и gRPC начинают слушать сразу, чтобы /live и /ready отвечали во время
+// подключения к Reindexer и миграций, а готовность выставляется после завершения запуска.
+// Отмена ctx запускает остановку: готовность снимается, сервер продолжает отвечать DrainDelay,
+// затем Shutdown останавливает все по порядку. Отмена во время запуска тоже штатная остановка, а не ошибка
 func Start(ctx context.Context) error {
-	app := New()
-	err := app.Config.Load()
+	app, err := loadConfig()
 	if err != nil {
-		return fmt.Errorf("load config: %w", err)
+		return err
 	}
 
 	app.Bootstrap()
 
-	app.logger.Info("starting app")
+	app.logger.Info("starting app", "version", Version)
 
+	defer app.Shutdown()
+
+	errCh := make(chan error, 3)
+	go func() {
+		errCh <- app.Server.ListenAndServe()
+	}()
+	go func() {
+		errCh <- app.serveGRPC()
+	}()
+	if app.AdminServer != nil {
+		go func() {
+			errCh <- app.AdminServer.ListenAndServe()
+		}()
+	}
+
+	if err := app.prepare(ctx); err != nil {
+		if ctx.Err() != nil {
+			app.logger.Info("shutting down before the app is ready", "error", err)
+			return nil
+		}
+		return err
+	}
+
+	// фоновые обработчики не зависят от ctx: они останавливаются в Shutdown после HTTP, а не по сигналу
+	workersCtx := context.WithoutCancel(ctx)
+
+	// наблюдение за соединением останавливается раньше, чем Shutdown закроет клиент
+	supervised := make(chan struct{})
+	superviseCtx, stopSupervisor := context.WithCancel(workersCtx)
+	go func() {
+		defer close(supervised)
+		app.DB.Supervise(superviseCtx, app.logConnection)
+	}()
+	defer func() {
+		stopSupervisor()
+		<-supervised
+	}()
+
+	go app.Dispatcher.Run(workersCtx)
+
+	purged := make(chan struct{})
+	purgeCtx, stopPurge := context.WithCancel(workersCtx)
+	go func() {
+		defer close(purged)
+		app.purgeTombstones(purgeCtx)
+	}()
+	defer func() {
+		stopPurge()
+		<-purged
+	}()
+
+	app.HealthChecker.SetReady(true)
+	app.logger.Info("app is ready")
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	app.logger.Info("shutting down")
+	app.drain()
+
+	return nil
+}
+
+// prepare подключается к Reindexer, применяет миграции и приводит индексы к конфигурации
+func (app *App) prepare(ctx context.Context) error {
 	if err := app.Srv.Start(ctx); err != nil {
 		return fmt.Errorf("start service: %w", err)
 	}
+	if app.Config.Migrations.Auto {
+		if err := app.migrate(ctx); err != nil {
+			return err
+		}
+	}
 
-	defer app.Shutdown()
+	return app.reconcileIndexes(ctx)
+}
+
+func (app *App) serveGRPC() error {
+	lis, err := net.Listen("tcp", net.JoinHostPort(app.Config.GRPC.Host, app.Config.GRPC.Port))
+	if err != nil {
+		return fmt.Errorf("listen grpc: %w", err)
+	}
+
+	return app.GRPCServer.Serve(lis)
+}
 
-	return app.Server.ListenAndServe()
+// purgeTombstones раз в PurgeInterval удаляет отметки об удалении старше Retention, пока не отменен ctx
+func (app *App) purgeTombstones(ctx context.Context) {
+	cfg := app.Config.Tombstones
+	if cfg.Retention <= 0 || cfg.PurgeInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(cfg.PurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		count, err := app.Srv.PurgeTombstones(ctx, cfg.Retention)
+		if err != nil {
+			if ctx.Err() == nil {
+				app.logger.Error(fmt.Errorf("purge tombstones: %w", err).Error())
+			}
+			continue
+		}
+		if count > 0 {
+			app.logger.Info("tombstones purged", "count", count)
+		}
+	}
+}
+
+func (app *App) logConnection(ready bool, err error) {
+	if ready {
+		app.logger.Info("reindexer connection restored")
+		return
+	}
+	app.logger.Error("reindexer connection lost", "error", err)
 }
@@ -0,0 +1,355 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/restream/reindexer"
+	"hash"
+	"io"
+	"strings"
+	"time"
+)
+
+// Архив резервной копии это gzip с JSON по одному на строку: заголовок с версией формата, пространством имен
+// и определениями индексов, затем хранимые документы, включая отметки об удалении, в порядке изменений,
+// и последней строкой итог с количеством документов и SHA-256 всех предыдущих строк
+const (
+	backupFormat  = "crud-backup"
+	backupVersion = 1
+
+	// maxBackupLineSize наибольший размер строки архива
+	maxBackupLineSize = 64 << 20
+)
+
+var ErrBackupCorrupted = errors.New("backup is corrupted")
+
+type backupHeader struct {
+	Format    string               `json:"format"`
+	Version   int                  `json:"version"`
+	Namespace string               `json:"namespace"`
+	CreatedAt time.Time            `json:"created_at"`
+	Indexes   []reindexer.IndexDef `json:"indexes"`
+}
+
+type backupTrailer struct {
+	Documents int    `json:"documents"`
+	SHA256    string `json:"sha256"`
+}
+
+// BackupInfo сведения об архиве
+type BackupInfo struct {
+	Namespace string
+	CreatedAt time.Time
+	Documents int
+	Checksum  string
+}
+
+// RestoreOptions параметры восстановления. Непустое пространство имен восстанавливается только с Truncate,
+// при этом его документы удаляются. BatchSize количество документов в одной транзакции
+type RestoreOptions struct {
+	Truncate  bool
+	BatchSize int
+}
+
+// defaultRestoreBatchSize размер транзакции восстановления, если он не задан
+const defaultRestoreBatchSize = 1000
+
+// Backup записывает архив пространства имен в w. Документы читаются порциями без остановки записи, поэтому
+// архив соответствует состоянию на момент окончания выгрузки: документ, измененный во время нее, может
+// попасть в архив дважды, при восстановлении остается последняя версия
+func (c Client) Backup(ctx context.Context, w io.Writer) (BackupInfo, error) {
+	desc, err := c.WithContext(ctx).DescribeNamespace(c.namespace)
+	if err != nil {
+		return BackupInfo{}, c.wrapError("client.Backup", err)
+	}
+
+	header := backupHeader{
+		Format:    backupFormat,
+		Version:   backupVersion,
+		Namespace: c.namespace,
+		CreatedAt: time.Now().UTC(),
+	}
+	for _, idx := range desc.Indexes {
+		if isServiceIndex(idx.Name) {
+			continue
+		}
+		header.Indexes = append(header.Indexes, reindexer.IndexDef(idx.IndexDef))
+	}
+
+	gz := gzip.NewWriter(w)
+	out := newBackupWriter(gz)
+	if err := out.writeJSON(header); err != nil {
+		return BackupInfo{}, fmt.Errorf("client.Backup: %w", err)
+	}
+
+	docs := c.iterateJSON("client.Backup", func() *reindexer.Query {
+		return c.WithContext(ctx).Query(c.namespace)
+	})
+	for doc, err := range docs {
+		if err != nil {
+			return BackupInfo{}, err
+		}
+		if err := out.writeLine(doc); err != nil {
+			return BackupInfo{}, fmt.Errorf("client.Backup: %w", err)
+		}
+		out.documents++
+	}
+
+	info := BackupInfo{
+		Namespace: header.Namespace,
+		CreatedAt: header.CreatedAt,
+		Documents: out.documents,
+		Checksum:  hex.EncodeToString(out.hash.Sum(nil)),
+	}
+	trailer, err := json.Marshal(backupTrailer{Documents: info.Documents, SHA256: info.Checksum})
+	if err != nil {
+		return BackupInfo{}, fmt.Errorf("client.Backup: %w", err)
+	}
+	if _, err := gz.Write(append(trailer, '\n')); err != nil {
+		return BackupInfo{}, fmt.Errorf("client.Backup: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return BackupInfo{}, fmt.Errorf("client.Backup: %w", err)
+	}
+
+	return info, nil
+}
+
+// VerifyBackup читает архив целиком и сверяет количество документов и контрольную сумму
+func VerifyBackup(r io.Reader) (BackupInfo, error) {
+	_, info, err := readBackup(r, nil)
+	return info, err
+}
+
+// Restore восстанавливает архив в пространство имен клиента, которое может отличаться от исходного.
+// Архив сначала проверяется целиком, поэтому поврежденный архив не приводит к частичному восстановлению.
+// Номера изменений назначаются заново в исходном порядке, клиентам синхронизации нужно начать ее сначала
+func (c Client) Restore(ctx context.Context, archive io.ReadSeeker, opts RestoreOptions) (BackupInfo, error) {
+	header, info, err := readBackup(archive, nil)
+	if err != nil {
+		return info, err
+	}
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return info, fmt.Errorf("client.Restore: %w", err)
+	}
+
+	db := c.WithContext(ctx)
+	if err := db.OpenNamespace(c.namespace, reindexer.DefaultNamespaceOptions(), Item{}); err != nil {
+		return info, fmt.Errorf("client.OpenNamespace: %w", err)
+	}
+
+	it := db.Query(c.namespace).Limit(0).ReqTotal().Exec()
+	total, err := it.TotalCount(), it.Error()
+	it.Close()
+	if err != nil {
+		return info, c.wrapError("client.Restore", err)
+	}
+	if total > 0 {
+		if !opts.Truncate {
+			return info, fmt.Errorf("client.Restore: namespace %s contains %d documents", c.namespace, total)
+		}
+		if err := db.TruncateNamespace(c.namespace); err != nil {
+			return info, c.wrapError("client.Restore", err)
+		}
+	}
+
+	if err := c.restoreIndexes(ctx, header.Indexes); err != nil {
+		return info, err
+	}
+
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultRestoreBatchSize
+	}
+
+	var tx *reindexer.Tx
+	pending := 0
+	_, _, err = readBackup(archive, func(doc []byte) error {
+		if tx == nil {
+			var err error
+			if tx, err = db.BeginTx(c.namespace); err != nil {
+				return c.wrapError("client.Restore", err)
+			}
+		}
+		if err := tx.UpsertJSON(doc, seqPrecept); err != nil {
+			return c.wrapError("client.Restore", err)
+		}
+
+		if pending++; pending < batchSize {
+			return nil
+		}
+		pending = 0
+		err := tx.Commit()
+		tx = nil
+		if err != nil {
+			return c.wrapError("client.Restore", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+		return info, err
+	}
+	if tx != nil {
+		if err := tx.Commit(); err != nil {
+			return info, c.wrapError("client.Restore", err)
+		}
+	}
+
+	return info, nil
+}
+
+// restoreIndexes добавляет недостающие индексы из архива и приводит к нему отличающиеся
+func (c Client) restoreIndexes(ctx context.Context, indexes []reindexer.IndexDef) error {
+	db := c.WithContext(ctx)
+	desc, err := db.DescribeNamespace(c.namespace)
+	if err != nil {
+		return c.wrapError("client.Restore", err)
+	}
+	existing := make(map[string]reindexer.IndexDef, len(desc.Indexes))
+	for _, idx := range desc.Indexes {
+		existing[idx.Name] = reindexer.IndexDef(idx.IndexDef)
+	}
+
+	for _, def := range indexes {
+		current, ok := existing[def.Name]
+		switch {
+		case !ok:
+			err = db.AddIndex(c.namespace, def)
+		case !sameIndex(current, def):
+			err = db.UpdateIndex(c.namespace, def)
+		default:
+			continue
+		}
+		if err != nil {
+			return c.wrapError(fmt.Sprintf("client.Restore index %s", def.Name), err)
+		}
+	}
+
+	return nil
+}
+
+// sameIndex сравнивает определения в том виде, в каком они хранятся в архиве
+func sameIndex(a, b reindexer.IndexDef) bool {
+	left, errA := json.Marshal(a)
+	right, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(left, right)
+}
+
+// isServiceIndex служебные индексы Reindexer создает сам
+func isServiceIndex(name string) bool {
+	return strings.HasPrefix(name, "-") || strings.HasPrefix(name, "#")
+}
+
+// readBackup читает архив, передавая документы в fn, если он задан. Ошибка проверки архива возвращается
+// только после прочтения итога, поэтому fn может получить документы поврежденного архива
+func readBackup(r io.Reader, fn func(doc []byte) error) (backupHeader, BackupInfo, error) {
+	var header backupHeader
+	var info BackupInfo
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return header, info, fmt.Errorf("%w: %w", ErrBackupCorrupted, err)
+	}
+	defer gz.Close()
+
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(nil, maxBackupLineSize)
+	if !scanner.Scan() {
+		return header, info, corrupted(scanner.Err(), "header is missing")
+	}
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil || header.Format != backupFormat {
+		return header, info, corrupted(nil, "header is malformed")
+	}
+	if header.Version != backupVersion {
+		return header, info, fmt.Errorf("backup format version %d is not supported", header.Version)
+	}
+	info.Namespace, info.CreatedAt = header.Namespace, header.CreatedAt
+
+	sum := sha256.New()
+	sum.Write(scanner.Bytes())
+	sum.Write([]byte{'\n'})
+
+	// последняя строка итог, поэтому строка передается дальше только после чтения следующей
+	var prev []byte
+	documents := -1
+	for scanner.Scan() {
+		if documents >= 0 {
+			sum.Write(prev)
+			sum.Write([]byte{'\n'})
+			if fn != nil {
+				if err := fn(prev); err != nil {
+					return header, info, err
+				}
+			}
+		}
+		prev = append(prev[:0], scanner.Bytes()...)
+		documents++
+	}
+	if err := scanner.Err(); err != nil {
+		return header, info, corrupted(err, "archive is truncated")
+	}
+	if documents < 0 {
+		return header, info, corrupted(nil, "trailer is missing")
+	}
+
+	var trailer backupTrailer
+	if err := json.Unmarshal(prev, &trailer); err != nil {
+		return header, info, corrupted(nil, "trailer is malformed")
+	}
+	info.Documents = documents
+	info.Checksum = hex.EncodeToString(sum.Sum(nil))
+	if trailer.Documents != documents {
+		return header, info, corrupted(nil, fmt.Sprintf("trailer lists %d documents, archive contains %d", trailer.Documents, documents))
+	}
+	if trailer.SHA256 != info.Checksum {
+		return header, info, corrupted(nil, "checksum mismatch")
+	}
+
+	return header, info, nil
+}
+
+func corrupted(err error, reason string) error {
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrBackupCorrupted, reason, err)
+	}
+
+	return fmt.Errorf("%w: %s", ErrBackupCorrupted, reason)
+}
+
+// backupWriter пишет строки архива, считая их контрольную сумму
+type backupWriter struct {
+	w         io.Writer
+	hash      hash.Hash
+	documents int
+}
+
+func newBackupWriter(w io.Writer) *backupWriter {
+	return &backupWriter{w: w, hash: sha256.New()}
+}
+
+func (b *backupWriter) writeJSON(v any) error {
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return b.writeLine(line)
+}
+
+func (b *backupWriter) writeLine(line []byte) error {
+	line = append(line, '\n')
+	b.hash.Write(line)
+	_, err := b.w.Write(line)
+	return err
+}
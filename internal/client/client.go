@@ -1,19 +1,39 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"crud/internal/config"
 	"crud/internal/domain"
+	"encoding/json"
 	"fmt"
 	"github.com/gofrs/uuid/v5"
 	"github.com/restream/reindexer"
 	_ "github.com/restream/reindexer/v4/bindings/cproto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"iter"
 	"net"
+	"time"
 )
 
+// iterateFetchCount количество документов, забираемых итератором из Reindexer за один запрос
+const iterateFetchCount = 100
+
 type Client struct {
 	*reindexer.Reindexer
 	namespace string
+
+	// indexes объявленные индексы, dropUnknownIndexes удалять необъявленные при сверке
+	indexes            []config.IndexConfig
+	dropUnknownIndexes bool
+
+	connect config.ConnectConfig
+	// state общее для копий клиента состояние соединения
+	state *connState
+
+	observer QueryObserver
+	tracer   trace.Tracer
 }
 
 func New(cfg config.DbConfig) *Client {
@@ -21,38 +41,58 @@ func New(cfg config.DbConfig) *Client {
 		fmt.Sprintf("cproto://%s/%s", net.JoinHostPort(cfg.Host, cfg.Port), cfg.Name),
 		reindexer.WithCreateDBIfMissing(),
 	)
-	return &Client{db, cfg.Namespace}
+	return &Client{
+		Reindexer:          db,
+		namespace:          cfg.Namespace,
+		indexes:            cfg.Indexes,
+		dropUnknownIndexes: cfg.DropUnknownIndexes,
+		connect:            cfg.Connect,
+		state:              &connState{},
+	}
 }
 
+// Start подключается к Reindexer и открывает пространства имен, повторяя попытки, пока Reindexer недоступен
 func (c Client) Start(ctx context.Context) error {
-	clientWithCtx := Client{
-		c.WithContext(ctx),
-		c.namespace,
+	err := c.retry(ctx, c.connect.Attempts, func() error {
+		return c.open(ctx)
+	})
+	if err != nil {
+		return err
 	}
+	c.state.ready.Store(true)
+
+	return nil
+}
+
+// open открывает пространства имен, повторное открытие безопасно
+func (c Client) open(ctx context.Context) error {
+	clientWithCtx := c
+	clientWithCtx.Reindexer = c.WithContext(ctx)
 	err := clientWithCtx.OpenNamespace(c.namespace, reindexer.DefaultNamespaceOptions(), Item{})
 	if err != nil {
 		return fmt.Errorf("client.OpenNamespace: %w", err)
 	}
 
-	err = clientWithCtx.AddIndex(c.namespace, reindexer.IndexDef{
-		Name:      "sorting",
-		IndexType: "tree",
-		FieldType: "int64",
-		JSONPaths: []string{"sort"},
-	})
-	if err != nil {
-		return fmt.Errorf("client.AddIndex: %w", err)
+	if err := clientWithCtx.openSyncNamespace(); err != nil {
+		return err
 	}
 
-	return nil
+	if err := clientWithCtx.openWebhookNamespaces(); err != nil {
+		return err
+	}
+
+	return clientWithCtx.openMigrationNamespaces()
 }
 
 func (c Client) Stop(ctx context.Context) {
+	c.state.ready.Store(false)
 	if !c.IsConnected(ctx) {
 		//закрытие закрытого канала под капотом Close вызовет панику
 		return
 	}
-	_ = c.CloseNamespace(c.namespace)
+	for _, ns := range []string{c.namespace, c.syncNamespace(), c.webhooksNamespace(), c.deliveriesNamespace(), c.migrationsNamespace(), c.migrationLockNamespace()} {
+		_ = c.CloseNamespace(ns)
+	}
 	c.WithContext(ctx).Close()
 }
 
@@ -61,24 +101,54 @@ func (c Client) IsConnected(ctx context.Context) bool {
 	return err == nil
 }
 
-func (c Client) CreateItem(ctx context.Context, item domain.Item) error {
+func (c Client) CreateItem(ctx context.Context, item domain.Item) (err error) {
+	ctx, done := c.observe(ctx, "client.CreateItem", idAttr(item.ID))
+	defer func() { done(err) }()
+
+	entry, err := c.outboxEntry(ctx, domain.EventCreated)
+	if err != nil {
+		return c.wrapError("client.CreateItem", err)
+	}
 	data := toDTO(item)
-	_, err := c.WithContext(ctx).Insert(c.namespace, &data)
+	if entry != "" {
+		data.Outbox = []string{entry}
+	}
+	count, err := c.WithContext(ctx).Insert(c.namespace, &data, seqPrecept)
 	if err != nil {
-		return fmt.Errorf("client.CreateItem: %w", err)
+		return c.wrapError("client.CreateItem", err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	// ID занят отметкой об удалении: документ создается поверх нее
+	it := replaceItem(c.WithContext(ctx).Query(c.namespace).
+		Where("id", reindexer.EQ, data.ID).
+		Where(indexDeleted, reindexer.EQ, true), data, entry).
+		Update()
+	defer it.Close()
+
+	if err := it.Error(); err != nil {
+		return c.wrapError("client.CreateItem", err)
+	}
+	if it.Count() == 0 {
+		return domain.Conflict("item %s already exists", item.ID)
 	}
 
 	return nil
 }
 
-func (c Client) GetItem(ctx context.Context, id uuid.UUID) (domain.Item, bool, error) {
-	query := c.WithContext(ctx).Query(c.namespace).Where("id", reindexer.EQ, id.String())
+func (c Client) GetItem(ctx context.Context, id uuid.UUID) (_ domain.Item, _ bool, err error) {
+	ctx, done := c.observe(ctx, "client.GetItem", idAttr(id))
+	defer func() { done(err) }()
+
+	query := c.liveItems(ctx).Where("id", reindexer.EQ, id.String())
 
 	var item domain.Item
 
 	it := query.Exec()
 	if err := it.Error(); err != nil {
-		return item, false, fmt.Errorf("client.GetItem: %w", err)
+		return item, false, c.wrapError("client.GetItem", err)
 	}
 
 	defer func() {
@@ -95,14 +165,45 @@ func (c Client) GetItem(ctx context.Context, id uuid.UUID) (domain.Item, bool, e
 	return dbItem.toModel(), true, nil
 }
 
-func (c Client) GetItems(ctx context.Context, pagination domain.Pagination, order domain.SortOrder) ([]domain.Item, error) {
-	query := c.WithContext(ctx).Query(c.namespace).Sort("sort", order == domain.OrderDesc).
+func (c Client) GetItemsByIDs(ctx context.Context, ids []uuid.UUID) (_ []domain.Item, err error) {
+	ctx, done := c.observe(ctx, "client.GetItemsByIDs", attribute.Int("db.reindexer.ids", len(ids)))
+	defer func() { done(err) }()
+
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, id.String())
+	}
+
+	it := c.liveItems(ctx).WhereString("id", reindexer.SET, keys...).Exec()
+	if err := it.Error(); err != nil {
+		return nil, c.wrapError("client.GetItemsByIDs", err)
+	}
+
+	defer func() {
+		it.Close()
+	}()
+
+	items := make([]domain.Item, 0, it.Count())
+	for it.Next() {
+		item := it.Object().(*Item)
+		items = append(items, item.toModel())
+	}
+
+	return items, nil
+}
+
+func (c Client) GetItems(ctx context.Context, filter domain.ItemFilter, pagination domain.Pagination, order domain.SortOrder) (_ []domain.Item, err error) {
+	ctx, done := c.observe(ctx, "client.GetItems", append(filterAttrs(filter), paginationAttrs(pagination)...)...)
+	defer func() { done(err) }()
+
+	query := c.filter(c.liveItems(ctx), filter).
+		Sort("sort", order == domain.OrderDesc).
 		Limit(pagination.Limit).
 		Offset(pagination.Offset)
 
 	it := query.Exec()
 	if err := it.Error(); err != nil {
-		return nil, fmt.Errorf("client.GetItems: %w", err)
+		return nil, c.wrapError("client.GetItems", err)
 	}
 
 	defer func() {
@@ -118,12 +219,108 @@ func (c Client) GetItems(ctx context.Context, pagination domain.Pagination, orde
 	return items, nil
 }
 
-func (c Client) GetItemsCount(ctx context.Context) (int64, error) {
-	query := c.WithContext(ctx).Query(c.namespace).ReqTotal()
+// IterateItems обходит документы по фильтру в порядке поля sort. Документы забираются из Reindexer порциями
+// по мере обхода, поэтому потребление памяти не зависит от размера пространства имен
+func (c Client) IterateItems(ctx context.Context, filter domain.ItemFilter, order domain.SortOrder) iter.Seq2[domain.Item, error] {
+	return func(yield func(domain.Item, error) bool) {
+		it := c.filter(c.liveItems(ctx), filter).
+			Sort("sort", order == domain.OrderDesc).
+			FetchCount(iterateFetchCount).
+			Exec()
+		defer it.Close()
+
+		for it.Next() {
+			item := it.Object().(*Item)
+			if !yield(item.toModel(), nil) {
+				return
+			}
+		}
+
+		if err := it.Error(); err != nil {
+			yield(domain.Item{}, c.wrapError("client.IterateItems", err))
+		}
+	}
+}
+
+// IterateItemsByChanges обходит документы по фильтру в порядке изменений. Служебные поля хранимого документа
+// (номер изменения, отметка об удалении, outbox) в модель не попадают
+func (c Client) IterateItemsByChanges(ctx context.Context, filter domain.ItemFilter) iter.Seq2[domain.Item, error] {
+	return func(yield func(domain.Item, error) bool) {
+		docs := c.iterateJSON("client.IterateItemsByChanges", func() *reindexer.Query {
+			return c.filter(c.liveItems(ctx), filter)
+		})
+		for doc, err := range docs {
+			if err != nil {
+				yield(domain.Item{}, err)
+				return
+			}
+
+			var item Item
+			if err := json.Unmarshal(doc, &item); err != nil {
+				yield(domain.Item{}, fmt.Errorf("client.IterateItemsByChanges: %w", err))
+				return
+			}
+			if !yield(item.toModel(), nil) {
+				return
+			}
+		}
+	}
+}
+
+// iterateJSON обходит документы запроса query в порядке изменений. Документы забираются порциями, каждая
+// следующая начинается после номера изменения последнего документа предыдущей, поэтому параллельная запись
+// не приводит к пропускам
+func (c Client) iterateJSON(op string, query func() *reindexer.Query) iter.Seq2[json.RawMessage, error] {
+	return func(yield func(json.RawMessage, error) bool) {
+		var after int64
+		for {
+			it := query().
+				Where(indexSeq, reindexer.GT, after).
+				Sort(indexSeq, false).
+				Limit(iterateFetchCount).
+				ExecToJson()
+			if err := it.Error(); err != nil {
+				it.Close()
+				yield(nil, c.wrapError(op, err))
+				return
+			}
+
+			count := it.Count()
+			for it.Next() {
+				// буфер итератора переиспользуется следующим запросом, поэтому документ копируется
+				doc := json.RawMessage(bytes.Clone(it.JSON()))
+
+				var pos struct{ Seq int64 }
+				if err := json.Unmarshal(doc, &pos); err != nil {
+					it.Close()
+					yield(nil, fmt.Errorf("%s: %w", op, err))
+					return
+				}
+				after = pos.Seq
+
+				if !yield(doc, nil) {
+					it.Close()
+					return
+				}
+			}
+			it.Close()
+
+			if count < iterateFetchCount {
+				return
+			}
+		}
+	}
+}
+
+func (c Client) GetItemsCount(ctx context.Context, filter domain.ItemFilter) (_ int64, err error) {
+	ctx, done := c.observe(ctx, "client.GetItemsCount", filterAttrs(filter)...)
+	defer func() { done(err) }()
+
+	query := c.filter(c.liveItems(ctx), filter).ReqTotal()
 
 	it := query.Exec()
 	if err := it.Error(); err != nil {
-		return 0, fmt.Errorf("client.GetItemsCount: %w", err)
+		return 0, c.wrapError("client.GetItemsCount", err)
 	}
 
 	defer func() {
@@ -135,27 +332,231 @@ func (c Client) GetItemsCount(ctx context.Context) (int64, error) {
 	return int64(res.Value), nil
 }
 
-func (c Client) UpdateItem(ctx context.Context, item domain.Item) error {
-	dbItem := toDTO(item)
-	query := c.WithContext(ctx).Query(c.namespace)
+func (c Client) UpdateItem(ctx context.Context, item domain.Item) (err error) {
+	ctx, done := c.observe(ctx, "client.UpdateItem", idAttr(item.ID))
+	defer func() { done(err) }()
 
-	query.Where("id", reindexer.EQ, dbItem.ID).
+	entry, err := c.outboxEntry(ctx, domain.EventUpdated)
+	if err != nil {
+		return c.wrapError("client.UpdateItem", err)
+	}
+	dbItem := toDTO(item)
+	it := withOutbox(c.liveItems(ctx).
+		Where("id", reindexer.EQ, dbItem.ID).
 		Set("name", dbItem.Name).
-		Set("related", dbItem.Related).
+		SetObject(fieldRelated, dbItem.Related).
+		Set(fieldUpdatedAt, formatTime(dbItem.UpdatedAt)).
+		SetExpression(indexSeq, seqExpression), entry).
 		Update()
+	defer it.Close()
 
-	if it := query.Exec(); it.Error() != nil {
-		return fmt.Errorf("client.UpdateItem: %w", it.Error())
+	if err := it.Error(); err != nil {
+		return c.wrapError("client.UpdateItem", err)
+	}
+	if it.Count() == 0 {
+		return domain.NotFound("item %s not found", item.ID)
 	}
 
 	return nil
 }
 
-func (c Client) DeleteItem(ctx context.Context, id uuid.UUID) error {
-	err := c.WithContext(ctx).Delete(c.namespace, &Item{ID: id.String()})
+// ImportItems записывает порцию импортируемых документов одной транзакцией вместе с их событиями в outbox.
+// Созданные документы заменяют отметки об удалении с тем же ID, обновленные заменяются целиком. И те и другие
+// сохраняют события outbox, еще не разобранные диспетчером. Документ из created, который другой запрос создал
+// после проверки существования, не перезаписывается, его ID возвращается в conflicts
+func (c Client) ImportItems(ctx context.Context, created, updated []domain.Item) (conflicts []uuid.UUID, err error) {
+	ctx, done := c.observe(ctx, "client.ImportItems", attribute.Int("db.reindexer.items", len(created)+len(updated)))
+	defer func() { done(err) }()
+
+	notifyCreated, err := c.subscribed(ctx, domain.EventCreated)
+	if err != nil {
+		return nil, c.wrapError("client.ImportItems", err)
+	}
+	notifyUpdated, err := c.subscribed(ctx, domain.EventUpdated)
+	if err != nil {
+		return nil, c.wrapError("client.ImportItems", err)
+	}
+
+	tx, err := c.WithContext(ctx).BeginTx(c.namespace)
+	if err != nil {
+		return nil, c.wrapError("client.ImportItems", err)
+	}
+	for _, item := range created {
+		data := toDTO(item)
+		var entry string
+		if notifyCreated {
+			entry = newOutboxEntry(domain.EventCreated)
+			data.Outbox = []string{entry}
+		}
+		// вставка не применяется, если есть отметка об удалении, тогда документ записывается поверх нее
+		err := tx.Insert(&data, seqPrecept)
+		if err == nil {
+			err = closeUpdate(replaceItem(tx.Query().Where("id", reindexer.EQ, data.ID).Where(indexDeleted, reindexer.EQ, true), data, entry))
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, c.wrapError("client.ImportItems", err)
+		}
+	}
+	for _, item := range updated {
+		data := toDTO(item)
+		var entry string
+		if notifyUpdated {
+			entry = newOutboxEntry(domain.EventUpdated)
+		}
+		if err := closeUpdate(replaceItem(tx.Query().Where("id", reindexer.EQ, data.ID), data, entry)); err != nil {
+			_ = tx.Rollback()
+			return nil, c.wrapError("client.ImportItems", err)
+		}
+	}
+	count, err := tx.CommitWithCount()
+	if err != nil {
+		return nil, c.wrapError("client.ImportItems", err)
+	}
+	// каждая запись меняет ровно один документ, кроме созданных, которые успел создать другой запрос:
+	// вставка для них не применяется, а замена затрагивает только отметки об удалении
+	if count >= len(created)+len(updated) {
+		return nil, nil
+	}
+
+	conflicts, err = c.lostCreates(ctx, created)
+	if err != nil {
+		return nil, c.wrapError("client.ImportItems", err)
+	}
+
+	return conflicts, nil
+}
+
+// lostCreates возвращает ID документов из created, которые записаны не импортом: все документы порции
+// получают одно время изменения, у чужого документа оно другое
+func (c Client) lostCreates(ctx context.Context, created []domain.Item) ([]uuid.UUID, error) {
+	ids := make([]string, 0, len(created))
+	for _, item := range created {
+		ids = append(ids, item.ID.String())
+	}
+
+	it := c.WithContext(ctx).Query(c.namespace).WhereString("id", reindexer.SET, ids...).Exec()
+	defer it.Close()
+
+	stored := make(map[uuid.UUID]domain.Item, len(created))
+	for it.Next() {
+		item := it.Object().(*Item).toModel()
+		stored[item.ID] = item
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	var lost []uuid.UUID
+	for _, item := range created {
+		got, ok := stored[item.ID]
+		if !ok || got.UpdatedAt == nil || item.UpdatedAt == nil || !got.UpdatedAt.Equal(*item.UpdatedAt) {
+			lost = append(lost, item.ID)
+		}
+	}
+
+	return lost, nil
+}
+
+// replaceItem дополняет запрос обновлением всех полей документа значениями data и событием entry в outbox, если оно есть.
+// В отличие от замены документа целиком, события outbox, записанные ранее, сохраняются
+func replaceItem(query *reindexer.Query, data Item, entry string) *reindexer.Query {
+	return withOutbox(query.
+		Set("name", data.Name).
+		Set("sort", data.Sort).
+		SetObject(fieldRelated, data.Related).
+		Set(fieldCreatedAt, formatTime(&data.CreatedAt)).
+		Set(fieldUpdatedAt, formatTime(data.UpdatedAt)).
+		Set(indexDeleted, false).
+		Set(indexDeletedAt, 0).
+		SetExpression(indexSeq, seqExpression), entry)
+}
+
+// closeUpdate выполняет запрос обновления и возвращает его ошибку
+func closeUpdate(query *reindexer.Query) error {
+	it := query.Update()
+	defer it.Close()
+
+	return it.Error()
+}
+
+// DeleteItem помечает документ удаленным. Вложенные документы очищаются, остальные поля остаются в отметке об удалении
+func (c Client) DeleteItem(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, done := c.observe(ctx, "client.DeleteItem", idAttr(id))
+	defer func() { done(err) }()
+
+	entry, err := c.outboxEntry(ctx, domain.EventDeleted)
 	if err != nil {
-		return fmt.Errorf("client.DeleteItem: %w", err)
+		return c.wrapError("client.DeleteItem", err)
+	}
+	deletedAt := time.Now()
+	it := withOutbox(c.liveItems(ctx).
+		Where("id", reindexer.EQ, id.String()).
+		Set(indexDeleted, true).
+		Set(indexDeletedAt, deletedAt.UnixNano()).
+		SetObject(fieldRelated, []Nested{}).
+		Set(fieldUpdatedAt, formatTime(&deletedAt)).
+		SetExpression(indexSeq, seqExpression), entry).
+		Update()
+	defer it.Close()
+
+	if err := it.Error(); err != nil {
+		return c.wrapError("client.DeleteItem", err)
+	}
+	if it.Count() == 0 {
+		return domain.NotFound("item %s not found", id)
 	}
 
 	return nil
 }
+
+// GetChanges возвращает до limit документов, включая удаленные, с номером изменения больше after в порядке изменений
+func (c Client) GetChanges(ctx context.Context, after int64, limit int) (_ []domain.Change, err error) {
+	ctx, done := c.observe(ctx, "client.GetChanges", attribute.Int64("db.reindexer.after", after), attribute.Int("db.reindexer.limit", limit))
+	defer func() { done(err) }()
+
+	it := c.WithContext(ctx).Query(c.namespace).
+		Where(indexSeq, reindexer.GT, after).
+		Sort(indexSeq, false).
+		Limit(limit).
+		Exec()
+	defer it.Close()
+
+	var changes []domain.Change
+	for it.Next() {
+		item := it.Object().(*Item)
+		changes = append(changes, domain.Change{
+			Seq:     item.Seq,
+			Item:    item.toModel(),
+			Deleted: item.Deleted,
+		})
+	}
+	if err := it.Error(); err != nil {
+		return nil, c.wrapError("client.GetChanges", err)
+	}
+
+	return changes, nil
+}
+
+// liveItems начинает запрос по неудаленным документам
+func (c Client) liveItems(ctx context.Context) *reindexer.Query {
+	return c.WithContext(ctx).Query(c.namespace).Where(indexDeleted, reindexer.EQ, false)
+}
+
+func (c Client) filter(query *reindexer.Query, filter domain.ItemFilter) *reindexer.Query {
+	if !filter.RelatedID.IsNil() {
+		query = query.WhereString(indexRelatedID, reindexer.EQ, filter.RelatedID.String())
+	}
+	if !filter.AtomID.IsNil() {
+		query = query.WhereString(indexAtomID, reindexer.EQ, filter.AtomID.String())
+	}
+	if !filter.ReferencedID.IsNil() {
+		query = query.OpenBracket().
+			WhereString(indexRelatedID, reindexer.EQ, filter.ReferencedID.String()).
+			Or().
+			WhereString(indexAtomID, reindexer.EQ, filter.ReferencedID.String()).
+			CloseBracket()
+	}
+
+	return query
+}
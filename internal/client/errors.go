@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"crud/internal/domain"
+	"errors"
+	"fmt"
+	"github.com/restream/reindexer/bindings"
+	"io"
+	"net"
+	"syscall"
+)
+
+// wrapError приводит ошибку Reindexer к ошибке предметной области. Текст исходной ошибки остается только в причине.
+// Ошибки неудачного подключения cproto отдает без кода и без исходной сетевой ошибки, поэтому, пока Supervise
+// считает соединение потерянным, такие ошибки тоже считаются недоступностью
+func (c Client) wrapError(op string, err error) error {
+	err = wrapError(op, err)
+	if errors.As(err, new(*domain.Error)) || c.state.ready.Load() {
+		return err
+	}
+
+	return domain.Unavailable(err)
+}
+
+// wrapError приводит ошибку Reindexer к ошибке предметной области по ее типу и коду
+func wrapError(op string, err error) error {
+	err = fmt.Errorf("%s: %w", op, err)
+
+	if canceled(err) {
+		return domain.Canceled(err)
+	}
+	if unavailable(err) {
+		return domain.Unavailable(err)
+	}
+
+	var rxErr bindings.Error
+	if errors.As(err, &rxErr) && rxErr.Code() == bindings.ErrConflict {
+		return domain.Conflict("document conflicts with the stored state").WithCause(err)
+	}
+
+	return err
+}
+
+// canceled запрос отменил вызывающий. Это не сбой Reindexer, и повторять запрос некому
+func canceled(err error) bool {
+	var rxErr bindings.Error
+	return errors.Is(err, context.Canceled) || errors.As(err, &rxErr) && rxErr.Code() == bindings.ErrCanceled
+}
+
+// unavailable ошибка связана с недоступностью Reindexer, а не с запросом, и запрос можно повторить.
+// Остальные ошибки, в том числе кодирования документов, повтором не исправить
+func unavailable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	for _, target := range []error{syscall.ECONNREFUSED, syscall.ECONNRESET, syscall.EPIPE, io.EOF, io.ErrUnexpectedEOF, net.ErrClosed} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	var rxErr bindings.Error
+	if !errors.As(err, &rxErr) {
+		return false
+	}
+
+	switch rxErr.Code() {
+	case bindings.ErrNetwork, bindings.ErrTimeout, bindings.ErrTerminated:
+		return true
+	}
+
+	return false
+}
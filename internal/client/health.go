@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Проверки состояния клиента для service.Checker
+
+// CheckConnection Reindexer отвечает на Ping, и клиент не восстанавливает потерянное соединение
+func (c Client) CheckConnection(ctx context.Context) error {
+	if err := c.WithContext(ctx).Ping(); err != nil {
+		return fmt.Errorf("reindexer ping: %w", err)
+	}
+	if !c.state.ready.Load() {
+		return errors.New("namespaces are not opened")
+	}
+
+	return nil
+}
+
+// CheckNamespace пространство имен документов существует
+func (c Client) CheckNamespace(ctx context.Context) error {
+	info, err := c.Inspect(ctx)
+	if err != nil {
+		return err
+	}
+	if !info.Exists {
+		return fmt.Errorf("namespace %s does not exist", c.namespace)
+	}
+
+	return nil
+}
+
+// CheckIndexes объявленные индексы созданы и совпадают с объявлением. Необъявленные индексы проверку не проваливают
+func (c Client) CheckIndexes(ctx context.Context) error {
+	report, err := c.ReconcileIndexes(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	var problems []string
+	if len(report.Added) > 0 {
+		problems = append(problems, "missing "+strings.Join(report.Added, ", "))
+	}
+	if len(report.Updated) > 0 {
+		problems = append(problems, "changed "+strings.Join(report.Updated, ", "))
+	}
+	if len(problems) > 0 {
+		return errors.New("indexes " + strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// CheckMigrations все миграции этой сборки применены
+func (c Client) CheckMigrations(ctx context.Context) error {
+	states, err := c.MigrationStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	var pending []string
+	for _, m := range states {
+		if !m.Applied {
+			pending = append(pending, fmt.Sprintf("%d %s", m.Version, m.Name))
+		}
+	}
+	if len(pending) > 0 {
+		return errors.New("migrations are pending: " + strings.Join(pending, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"crud/internal/config"
+	"fmt"
+	"github.com/restream/reindexer"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Индексы пространства имен документов бывают двух видов: выводимые Reindexer из тегов структуры Item при
+// открытии пространства имен и объявленные в конфигурации. Сверка касается только объявленных: недостающие
+// добавляются, отличающиеся обновляются. Остальные индексы, кроме служебных и выводимых из тегов,
+// считаются неизвестными и удаляются, только если это разрешено
+
+// IndexReport итог сверки индексов. Unknown перечисляет неизвестные индексы, которые остались на месте
+type IndexReport struct {
+	Added   []string
+	Updated []string
+	Dropped []string
+	Unknown []string
+}
+
+// Changed есть ли расхождения с объявлением
+func (r IndexReport) Changed() bool {
+	return len(r.Added)+len(r.Updated)+len(r.Dropped) > 0
+}
+
+// ReconcileIndexes приводит индексы пространства имен к объявленным. При dryRun только возвращает расхождения
+func (c Client) ReconcileIndexes(ctx context.Context, dryRun bool) (IndexReport, error) {
+	var report IndexReport
+
+	declared := make(map[string]reindexer.IndexDef, len(c.indexes))
+	tagged := tagIndexes(reflect.TypeOf(Item{}), "", map[string]bool{})
+	for _, idx := range c.indexes {
+		def, err := indexDef(idx)
+		if err != nil {
+			return report, err
+		}
+		if tagged[def.Name] {
+			return report, fmt.Errorf("client.ReconcileIndexes: index %s is derived from the document struct and cannot be declared", def.Name)
+		}
+		if _, ok := declared[def.Name]; ok {
+			return report, fmt.Errorf("client.ReconcileIndexes: index %s is declared twice", def.Name)
+		}
+		declared[def.Name] = def
+	}
+
+	db := c.WithContext(ctx)
+	desc, err := db.DescribeNamespace(c.namespace)
+	if err != nil {
+		return report, c.wrapError("client.ReconcileIndexes", err)
+	}
+	existing := make(map[string]reindexer.IndexDef, len(desc.Indexes))
+	for _, idx := range desc.Indexes {
+		existing[idx.Name] = reindexer.IndexDef(idx.IndexDef)
+		if isServiceIndex(idx.Name) || tagged[idx.Name] {
+			continue
+		}
+		if _, ok := declared[idx.Name]; ok {
+			continue
+		}
+		if !c.dropUnknownIndexes {
+			report.Unknown = append(report.Unknown, idx.Name)
+			continue
+		}
+		if !dryRun {
+			if err := db.DropIndex(c.namespace, idx.Name); err != nil {
+				return report, c.wrapError(fmt.Sprintf("client.DropIndex %s", idx.Name), err)
+			}
+		}
+		report.Dropped = append(report.Dropped, idx.Name)
+	}
+
+	// индексы добавляются в порядке объявления, составной индекс объявляется после входящих в него
+	for _, idx := range c.indexes {
+		def := declared[idx.Name]
+		current, ok := existing[def.Name]
+		switch {
+		case !ok:
+			if !dryRun {
+				err = db.AddIndex(c.namespace, def)
+			}
+			report.Added = append(report.Added, def.Name)
+		case indexChanged(current, def):
+			if !dryRun {
+				err = db.UpdateIndex(c.namespace, def)
+			}
+			report.Updated = append(report.Updated, def.Name)
+		}
+		if err != nil {
+			return report, c.wrapError(fmt.Sprintf("client.ReconcileIndexes %s", def.Name), err)
+		}
+	}
+
+	return report, nil
+}
+
+// indexDef переводит объявление из конфигурации в определение индекса Reindexer
+func indexDef(idx config.IndexConfig) (reindexer.IndexDef, error) {
+	if idx.Name == "" || idx.IndexType == "" || idx.FieldType == "" {
+		return reindexer.IndexDef{}, fmt.Errorf("client: index %q must have name, index_type and field_type", idx.Name)
+	}
+
+	def := reindexer.IndexDef{
+		Name:        idx.Name,
+		JSONPaths:   idx.JSONPaths,
+		IndexType:   idx.IndexType,
+		FieldType:   idx.FieldType,
+		IsArray:     idx.Array,
+		IsSparse:    idx.Sparse,
+		IsDense:     idx.Dense,
+		CollateMode: idx.Collate,
+		SortOrder:   idx.SortOrder,
+	}
+	if len(def.JSONPaths) == 0 {
+		def.JSONPaths = []string{def.Name}
+	}
+	if def.CollateMode == "" {
+		def.CollateMode = "none"
+	}
+
+	return def, nil
+}
+
+// indexChanged сравнивает поля, которые можно объявить. Reindexer возвращает описание индекса
+// с заполненными значениями по умолчанию, поэтому определения целиком не сравниваются
+func indexChanged(current, want reindexer.IndexDef) bool {
+	collate := func(mode string) string {
+		if mode == "" {
+			return "none"
+		}
+		return mode
+	}
+
+	return !slices.Equal(current.JSONPaths, want.JSONPaths) ||
+		!strings.EqualFold(current.IndexType, want.IndexType) ||
+		!strings.EqualFold(current.FieldType, want.FieldType) ||
+		current.IsArray != want.IsArray ||
+		current.IsSparse != want.IsSparse ||
+		current.IsDense != want.IsDense ||
+		collate(current.CollateMode) != collate(want.CollateMode) ||
+		current.SortOrder != want.SortOrder
+}
+
+// tagIndexes собирает имена индексов, которые Reindexer выводит из тегов reindex, в names
+func tagIndexes(t reflect.Type, prefix string, names map[string]bool) map[string]bool {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag, ok := field.Tag.Lookup("reindex")
+		if !ok {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		ft := field.Type
+		for ft.Kind() == reflect.Slice || ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct && ft != reflect.TypeOf(time.Time{}) {
+			tagIndexes(ft, prefix+name+".", names)
+			continue
+		}
+		names[prefix+name] = true
+	}
+
+	return names
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"github.com/restream/reindexer/bindings"
+)
+
+// NamespaceInfo состояние пространства имен документов. Documents включает отметки об удалении
+type NamespaceInfo struct {
+	Name      string
+	Exists    bool
+	Documents int64
+	Indexes   []string
+}
+
+// Inspect возвращает состояние пространства имен, не открывая и не создавая его
+func (c Client) Inspect(ctx context.Context) (NamespaceInfo, error) {
+	info := NamespaceInfo{Name: c.namespace}
+	db := c.WithContext(ctx)
+
+	desc, err := db.DescribeNamespace(c.namespace)
+	var rxErr bindings.Error
+	if errors.As(err, &rxErr) && rxErr.Code() == bindings.ErrNotFound {
+		return info, nil
+	}
+	if err != nil {
+		return info, c.wrapError("client.Inspect", err)
+	}
+	info.Exists = true
+	for _, idx := range desc.Indexes {
+		if !isServiceIndex(idx.Name) {
+			info.Indexes = append(info.Indexes, idx.Name)
+		}
+	}
+
+	stat, err := db.GetNamespaceMemStat(c.namespace)
+	if err != nil {
+		return info, c.wrapError("client.Inspect", err)
+	}
+	info.Documents = stat.ItemsCount
+
+	return info, nil
+}
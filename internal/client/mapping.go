@@ -6,6 +6,36 @@ import (
 	"time"
 )
 
+// Пути полей хранимого документа. json-теги у структур не заданы, поэтому в документе используются имена полей Go
+const (
+	fieldRelated   = "Related"
+	fieldCreatedAt = "CreatedAt"
+	fieldUpdatedAt = "UpdatedAt"
+)
+
+// Индексы по ID вложенных документов и атомов
+const (
+	indexRelatedID = "related.id"
+	indexAtomID    = "related.related.id"
+)
+
+// Индексы для инкрементальной синхронизации. seq заполняется Reindexer через serial() при каждой записи документа,
+// удаленный документ остается в пространстве имен с флагом deleted как отметка об удалении до истечения срока хранения,
+// отсчитываемого от deletedAt
+const (
+	indexSeq       = "seq"
+	indexDeleted   = "deleted"
+	indexDeletedAt = "deletedAt"
+
+	// precept и выражение, назначающие документу следующий номер изменения
+	seqPrecept    = indexSeq + "=serial()"
+	seqExpression = "serial()"
+)
+
+// indexOutbox события документа, ожидающие разбора диспетчером вебхуков. Событие записывается тем же запросом,
+// что и изменение документа, поэтому оба применяются или не применяются вместе
+const indexOutbox = "outbox"
+
 type Item struct {
 	ID        string     `reindex:"id,,pk"`
 	Sort      int64      `reindex:"sort"`
@@ -13,6 +43,10 @@ type Item struct {
 	Related   []Nested   `reindex:"related"`
 	CreatedAt time.Time  `reindex:"createdAt"`
 	UpdatedAt *time.Time `reindex:"updatedAt"`
+	Seq       int64      `reindex:"seq,tree"`
+	Deleted   bool       `reindex:"deleted"`
+	DeletedAt int64      `reindex:"deletedAt,tree"`
+	Outbox    []string   `reindex:"outbox"`
 }
 
 func (it Item) toModel() domain.Item {
@@ -57,11 +91,16 @@ func (nst Nested) toModel() domain.Nested {
 }
 
 func nestedToDTO(n domain.Nested) Nested {
+	atoms := make([]Atom, 0, len(n.Related))
+	for _, a := range n.Related {
+		atoms = append(atoms, atomToDTO(a))
+	}
+
 	return Nested{
 		ID:      n.ID.String(),
 		Name:    n.Name,
 		Sort:    n.Sort,
-		Related: nil,
+		Related: atoms,
 	}
 }
 
@@ -78,17 +117,28 @@ func (a Atom) toModel() domain.Atom {
 	}
 }
 
-func toDTO(it domain.Item) Item {
-	subItems := make([]Nested, 0, len(it.Related))
-	for _, nst := range it.Related {
+func atomToDTO(a domain.Atom) Atom {
+	return Atom{
+		ID:   a.ID.String(),
+		Name: a.Name,
+	}
+}
+
+func relatedToDTO(related []domain.Nested) []Nested {
+	subItems := make([]Nested, 0, len(related))
+	for _, nst := range related {
 		subItems = append(subItems, nestedToDTO(nst))
 	}
 
+	return subItems
+}
+
+func toDTO(it domain.Item) Item {
 	return Item{
 		ID:        it.ID.String(),
 		Sort:      it.Sort,
 		Name:      it.Name,
-		Related:   subItems,
+		Related:   relatedToDTO(it.Related),
 		CreatedAt: it.CreatedAt,
 		UpdatedAt: it.UpdatedAt,
 	}
@@ -0,0 +1,303 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/restream/reindexer"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Примененные миграции записываются в отдельное пространство имен рядом с документами. Чтобы при запуске
+// нескольких реплик миграции выполняла только одна, на время выполнения берется блокировка: документ с
+// ограниченным сроком действия, который владелец продлевает, пока работает. Блокировка упавшей реплики
+// перехватывается после истечения срока. Запись о миграции делается после ее выполнения, поэтому миграция,
+// прерванная на середине, будет выполнена заново и должна это допускать
+
+const (
+	migrationLockID = "migrations"
+
+	// migrationLockPoll интервал повторных попыток взять занятую блокировку
+	migrationLockPoll = time.Second
+
+	defaultMigrationLockTTL  = time.Minute
+	defaultMigrationLockWait = 5 * time.Minute
+)
+
+var (
+	ErrMigrationLocked       = errors.New("migrations are locked by another process")
+	ErrMigrationLockLost     = errors.New("migration lock is lost")
+	ErrMigrationIrreversible = errors.New("migration is irreversible")
+)
+
+type AppliedMigration struct {
+	Version   int       `reindex:"version,,pk"`
+	Name      string    `reindex:"name"`
+	AppliedAt time.Time `reindex:"appliedAt"`
+}
+
+type MigrationLock struct {
+	ID    string `reindex:"id,,pk"`
+	Owner string `reindex:"owner"`
+	// ExpiresAt окончание срока блокировки в наносекундах
+	ExpiresAt int64 `reindex:"expiresAt,tree"`
+}
+
+// MigrationState состояние миграции. Unknown означает, что миграция применена, но отсутствует в этой сборке
+type MigrationState struct {
+	Version   int
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+	Unknown   bool
+}
+
+// MigrateOptions параметры блокировки: LockTTL срок, на который она берется и продлевается,
+// LockWait сколько ждать блокировку, занятую другим процессом
+type MigrateOptions struct {
+	LockTTL  time.Duration
+	LockWait time.Duration
+}
+
+func (o MigrateOptions) withDefaults() MigrateOptions {
+	if o.LockTTL <= 0 {
+		o.LockTTL = defaultMigrationLockTTL
+	}
+	if o.LockWait <= 0 {
+		o.LockWait = defaultMigrationLockWait
+	}
+
+	return o
+}
+
+func (c Client) migrationsNamespace() string {
+	return c.namespace + "_migrations"
+}
+
+func (c Client) migrationLockNamespace() string {
+	return c.namespace + "_migrations_lock"
+}
+
+func (c Client) openMigrationNamespaces() error {
+	if err := c.OpenNamespace(c.migrationsNamespace(), reindexer.DefaultNamespaceOptions(), AppliedMigration{}); err != nil {
+		return fmt.Errorf("client.OpenNamespace %s: %w", c.migrationsNamespace(), err)
+	}
+	if err := c.OpenNamespace(c.migrationLockNamespace(), reindexer.DefaultNamespaceOptions(), MigrationLock{}); err != nil {
+		return fmt.Errorf("client.OpenNamespace %s: %w", c.migrationLockNamespace(), err)
+	}
+
+	return nil
+}
+
+// MigrationStatus возвращает все известные и примененные миграции в порядке версий
+func (c Client) MigrationStatus(ctx context.Context) ([]MigrationState, error) {
+	applied, err := c.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]MigrationState, 0, len(migrations))
+	for _, m := range migrations {
+		state := MigrationState{Version: m.version, Name: m.name}
+		if a, ok := applied[m.version]; ok {
+			state.Applied, state.AppliedAt = true, a.AppliedAt
+			delete(applied, m.version)
+		}
+		states = append(states, state)
+	}
+	for _, a := range applied {
+		states = append(states, MigrationState{Version: a.Version, Name: a.Name, Applied: true, AppliedAt: a.AppliedAt, Unknown: true})
+	}
+	slices.SortFunc(states, func(a, b MigrationState) int {
+		return a.Version - b.Version
+	})
+
+	return states, nil
+}
+
+// MigrateUp применяет недостающие миграции по порядку и возвращает примененные
+func (c Client) MigrateUp(ctx context.Context, opts MigrateOptions) (done []MigrationState, err error) {
+	err = c.withMigrationLock(ctx, opts, func(ctx context.Context) error {
+		applied, err := c.appliedMigrations(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range migrations {
+			if _, ok := applied[m.version]; ok {
+				continue
+			}
+			if err := m.up(ctx, c); err != nil {
+				return fmt.Errorf("migration %d %s: %w", m.version, m.name, err)
+			}
+			record := AppliedMigration{Version: m.version, Name: m.name, AppliedAt: time.Now().UTC()}
+			if _, err := c.WithContext(ctx).Insert(c.migrationsNamespace(), &record); err != nil {
+				return c.wrapError("client.MigrateUp", err)
+			}
+			done = append(done, MigrationState{Version: m.version, Name: m.name, Applied: true, AppliedAt: record.AppliedAt})
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+// MigrateDown откатывает steps последних примененных миграций и возвращает откаченные
+func (c Client) MigrateDown(ctx context.Context, steps int, opts MigrateOptions) (done []MigrationState, err error) {
+	err = c.withMigrationLock(ctx, opts, func(ctx context.Context) error {
+		applied, err := c.appliedMigrations(ctx)
+		if err != nil {
+			return err
+		}
+		versions := make([]int, 0, len(applied))
+		for v := range applied {
+			versions = append(versions, v)
+		}
+		slices.Sort(versions)
+		slices.Reverse(versions)
+
+		for _, v := range versions[:min(steps, len(versions))] {
+			idx := slices.IndexFunc(migrations, func(m migration) bool { return m.version == v })
+			if idx < 0 {
+				return fmt.Errorf("migration %d %s is not known to this build", v, applied[v].Name)
+			}
+			m := migrations[idx]
+			if m.down == nil {
+				return fmt.Errorf("migration %d %s: %w", m.version, m.name, ErrMigrationIrreversible)
+			}
+			if err := m.down(ctx, c); err != nil {
+				return fmt.Errorf("migration %d %s: %w", m.version, m.name, err)
+			}
+			if err := c.WithContext(ctx).Delete(c.migrationsNamespace(), &AppliedMigration{Version: v}); err != nil {
+				return c.wrapError("client.MigrateDown", err)
+			}
+			done = append(done, MigrationState{Version: m.version, Name: m.name})
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+func (c Client) appliedMigrations(ctx context.Context) (map[int]AppliedMigration, error) {
+	it := c.WithContext(ctx).Query(c.migrationsNamespace()).Exec()
+	defer it.Close()
+
+	applied := make(map[int]AppliedMigration)
+	for it.Next() {
+		m := it.Object().(*AppliedMigration)
+		applied[m.Version] = *m
+	}
+	if err := it.Error(); err != nil {
+		return nil, c.wrapError("client.appliedMigrations", err)
+	}
+
+	return applied, nil
+}
+
+// withMigrationLock выполняет fn под блокировкой миграций. Если блокировку не удалось продлить,
+// контекст fn отменяется с ErrMigrationLockLost
+func (c Client) withMigrationLock(ctx context.Context, opts MigrateOptions, fn func(ctx context.Context) error) error {
+	opts = opts.withDefaults()
+	owner := lockOwner()
+	if err := c.acquireMigrationLock(ctx, owner, opts); err != nil {
+		return err
+	}
+
+	lockCtx, cancel := context.WithCancelCause(ctx)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(opts.LockTTL / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-lockCtx.Done():
+				return
+			case <-ticker.C:
+				if !c.extendMigrationLock(lockCtx, owner, opts.LockTTL) {
+					cancel(ErrMigrationLockLost)
+					return
+				}
+			}
+		}
+	}()
+
+	err := fn(lockCtx)
+	if cause := context.Cause(lockCtx); errors.Is(cause, ErrMigrationLockLost) {
+		err = errors.Join(err, cause)
+	}
+	cancel(nil)
+	<-stopped
+
+	// блокировка снимается и при отмене ctx, иначе остальные реплики ждали бы истечения срока
+	release := context.WithoutCancel(ctx)
+	_, releaseErr := c.WithContext(release).Query(c.migrationLockNamespace()).
+		WhereString("id", reindexer.EQ, migrationLockID).
+		WhereString("owner", reindexer.EQ, owner).
+		Delete()
+	if releaseErr != nil {
+		return errors.Join(err, c.wrapError("client.releaseMigrationLock", releaseErr))
+	}
+
+	return err
+}
+
+// acquireMigrationLock берет свободную или просроченную блокировку, ожидая не дольше opts.LockWait
+func (c Client) acquireMigrationLock(ctx context.Context, owner string, opts MigrateOptions) error {
+	ctx, cancel := context.WithTimeout(ctx, opts.LockWait)
+	defer cancel()
+
+	db := c.WithContext(ctx)
+	for {
+		now := time.Now()
+		expiresAt := now.Add(opts.LockTTL).UnixNano()
+
+		count, err := db.Insert(c.migrationLockNamespace(), &MigrationLock{ID: migrationLockID, Owner: owner, ExpiresAt: expiresAt})
+		if err == nil && count == 0 {
+			it := db.Query(c.migrationLockNamespace()).
+				WhereString("id", reindexer.EQ, migrationLockID).
+				WhereInt64("expiresAt", reindexer.LT, now.UnixNano()).
+				Set("owner", owner).
+				Set("expiresAt", expiresAt).
+				Update()
+			count, err = it.Count(), it.Error()
+			it.Close()
+		}
+		if err != nil {
+			return c.wrapError("client.acquireMigrationLock", err)
+		}
+		if count > 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: waited %s", ErrMigrationLocked, opts.LockWait)
+		case <-time.After(migrationLockPoll):
+		}
+	}
+}
+
+// extendMigrationLock продлевает блокировку владельца, false означает, что блокировка потеряна
+func (c Client) extendMigrationLock(ctx context.Context, owner string, ttl time.Duration) bool {
+	it := c.WithContext(ctx).Query(c.migrationLockNamespace()).
+		WhereString("id", reindexer.EQ, migrationLockID).
+		WhereString("owner", reindexer.EQ, owner).
+		Set("expiresAt", time.Now().Add(ttl).UnixNano()).
+		Update()
+	defer it.Close()
+
+	return it.Error() == nil && it.Count() > 0
+}
+
+// lockOwner уникальное имя процесса для блокировки
+func lockOwner() string {
+	host, _ := os.Hostname()
+	return host + ":" + strconv.Itoa(os.Getpid()) + ":" + strconv.FormatInt(time.Now().UnixNano(), 36)
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"github.com/restream/reindexer"
+)
+
+// migration изменение схемы или хранимых документов. down равен nil у необратимых миграций
+type migration struct {
+	version int
+	name    string
+	up      func(ctx context.Context, c Client) error
+	down    func(ctx context.Context, c Client) error
+}
+
+// migrations упорядочены по версии, новые добавляются в конец. Примененную миграцию нельзя менять,
+// исправление оформляется следующей миграцией. Индексы миграции не создают: ими управляет конфигурация,
+// см. ReconcileIndexes
+var migrations = []migration{
+	{
+		version: 1,
+		name:    "backfill seq",
+		// документам, записанным до появления номера изменения, номер назначается один раз.
+		// Откат не нужен: номер не мешает коду без ленты изменений
+		up: func(ctx context.Context, c Client) error {
+			it := c.WithContext(ctx).Query(c.namespace).
+				Where(indexSeq, reindexer.EQ, 0).
+				SetExpression(indexSeq, seqExpression).
+				Update()
+			defer it.Close()
+			if err := it.Error(); err != nil {
+				return fmt.Errorf("client.Update seq: %w", err)
+			}
+
+			return nil
+		},
+	},
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"crud/internal/domain"
+	"crud/internal/logging"
+	"errors"
+	"github.com/gofrs/uuid/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"log/slog"
+	"time"
+)
+
+const tracerName = "crud/internal/client"
+
+// QueryObserver получает длительность и ошибку каждой операции клиента с Reindexer
+type QueryObserver interface {
+	ObserveQuery(op string, duration time.Duration, err error)
+}
+
+// SetObserver подключает наблюдение за операциями клиента
+func (c *Client) SetObserver(o QueryObserver) {
+	c.observer = o
+}
+
+// SetTracerProvider подключает трассировку операций клиента
+func (c *Client) SetTracerProvider(tp trace.TracerProvider) {
+	c.tracer = tp.Tracer(tracerName)
+}
+
+// observe начинает наблюдение за операцией op. Возвращенный контекст передается в запросы операции,
+// done завершает наблюдение с ее ошибкой и пишет операцию в лог запроса на уровне debug. Span создается
+// только внутри уже начатой трассы: фоновые опросы диспетчера вебхуков иначе порождали бы по трассе
+// на каждый опрос. attrs описывают условия запроса и могут заменить пространство имен, если операция
+// обращается не к документам
+func (c Client) observe(ctx context.Context, op string, attrs ...attribute.KeyValue) (_ context.Context, done func(err error)) {
+	start := time.Now()
+
+	span := trace.SpanFromContext(ctx)
+	if c.tracer != nil && span.SpanContext().IsValid() {
+		ctx, span = c.tracer.Start(ctx, op, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+			append([]attribute.KeyValue{
+				attribute.String("db.system.name", "reindexer"),
+				attribute.String("db.operation.name", op),
+				namespaceAttr(c.namespace),
+			}, attrs...)...,
+		))
+	} else {
+		span = noop.Span{}
+	}
+
+	return ctx, func(err error) {
+		duration := time.Since(start)
+		if c.observer != nil {
+			c.observer.ObserveQuery(op, duration, err)
+		}
+		logAttrs := []slog.Attr{
+			slog.String("operation", op),
+			slog.Float64("latency_ms", float64(duration)/float64(time.Millisecond)),
+		}
+		if err != nil {
+			logAttrs = append(logAttrs, slog.String("error", err.Error()))
+		}
+		logging.FromContext(ctx).LogAttrs(ctx, slog.LevelDebug, "reindexer query", logAttrs...)
+
+		// документ, которого нет, не считается ошибкой запроса
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+func namespaceAttr(namespace string) attribute.KeyValue {
+	return attribute.String("db.namespace", namespace)
+}
+
+func idAttr(id uuid.UUID) attribute.KeyValue {
+	return attribute.String("db.reindexer.id", id.String())
+}
+
+func filterAttrs(filter domain.ItemFilter) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if !filter.RelatedID.IsNil() {
+		attrs = append(attrs, attribute.String("db.reindexer.filter.related_id", filter.RelatedID.String()))
+	}
+	if !filter.AtomID.IsNil() {
+		attrs = append(attrs, attribute.String("db.reindexer.filter.atom_id", filter.AtomID.String()))
+	}
+	if !filter.ReferencedID.IsNil() {
+		attrs = append(attrs, attribute.String("db.reindexer.filter.referenced_id", filter.ReferencedID.String()))
+	}
+
+	return attrs
+}
+
+func paginationAttrs(pagination domain.Pagination) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.Int("db.reindexer.limit", pagination.Limit),
+		attribute.Int("db.reindexer.offset", pagination.Offset),
+	}
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"crud/internal/domain"
+	"fmt"
+	"github.com/restream/reindexer"
+	"time"
+)
+
+// Точечные изменения вложенных документов. Каждое изменение применяется только к той версии документа,
+// из которой оно было построено: версия определяется полем UpdatedAt, при несовпадении возвращается ErrPreconditionFailed
+
+// SetRelated перезаписывает массив вложенных документов
+func (c Client) SetRelated(ctx context.Context, item domain.Item, updatedAt time.Time) error {
+	return c.modifyItem(ctx, "client.SetRelated", item, updatedAt, func(q *reindexer.Query) *reindexer.Query {
+		return q.SetObject(fieldRelated, relatedToDTO(item.Related))
+	})
+}
+
+// SetNested перезаписывает вложенный документ с индексом idx
+func (c Client) SetNested(ctx context.Context, item domain.Item, updatedAt time.Time, idx int) error {
+	return c.modifyItem(ctx, "client.SetNested", item, updatedAt, func(q *reindexer.Query) *reindexer.Query {
+		return q.SetObject(nestedPath(idx), nestedToDTO(item.Related[idx]))
+	})
+}
+
+// DropNested удаляет вложенный документ с индексом idx
+func (c Client) DropNested(ctx context.Context, item domain.Item, updatedAt time.Time, idx int) error {
+	return c.modifyItem(ctx, "client.DropNested", item, updatedAt, func(q *reindexer.Query) *reindexer.Query {
+		return q.Drop(nestedPath(idx))
+	})
+}
+
+// SetAtoms перезаписывает массив атомов вложенного документа с индексом nestedIdx
+func (c Client) SetAtoms(ctx context.Context, item domain.Item, updatedAt time.Time, nestedIdx int) error {
+	atoms := make([]Atom, 0, len(item.Related[nestedIdx].Related))
+	for _, a := range item.Related[nestedIdx].Related {
+		atoms = append(atoms, atomToDTO(a))
+	}
+
+	return c.modifyItem(ctx, "client.SetAtoms", item, updatedAt, func(q *reindexer.Query) *reindexer.Query {
+		return q.SetObject(nestedPath(nestedIdx)+"."+fieldRelated, atoms)
+	})
+}
+
+// SetAtom перезаписывает атом с индексом atomIdx во вложенном документе с индексом nestedIdx
+func (c Client) SetAtom(ctx context.Context, item domain.Item, updatedAt time.Time, nestedIdx, atomIdx int) error {
+	return c.modifyItem(ctx, "client.SetAtom", item, updatedAt, func(q *reindexer.Query) *reindexer.Query {
+		return q.SetObject(atomPath(nestedIdx, atomIdx), atomToDTO(item.Related[nestedIdx].Related[atomIdx]))
+	})
+}
+
+// DropAtom удаляет атом с индексом atomIdx из вложенного документа с индексом nestedIdx
+func (c Client) DropAtom(ctx context.Context, item domain.Item, updatedAt time.Time, nestedIdx, atomIdx int) error {
+	return c.modifyItem(ctx, "client.DropAtom", item, updatedAt, func(q *reindexer.Query) *reindexer.Query {
+		return q.Drop(atomPath(nestedIdx, atomIdx))
+	})
+}
+
+func (c Client) modifyItem(ctx context.Context, op string, item domain.Item, updatedAt time.Time, modify func(q *reindexer.Query) *reindexer.Query) (err error) {
+	ctx, done := c.observe(ctx, op, idAttr(item.ID))
+	defer func() { done(err) }()
+
+	query := c.liveItems(ctx).Where("id", reindexer.EQ, item.ID.String())
+	if item.UpdatedAt != nil {
+		query = query.Where(fieldUpdatedAt, reindexer.EQ, formatTime(item.UpdatedAt))
+	} else {
+		query = query.Where(fieldUpdatedAt, reindexer.EMPTY, nil)
+	}
+
+	entry, err := c.outboxEntry(ctx, domain.EventUpdated)
+	if err != nil {
+		return c.wrapError(op, err)
+	}
+	it := withOutbox(modify(query).
+		Set(fieldUpdatedAt, formatTime(&updatedAt)).
+		SetExpression(indexSeq, seqExpression), entry).
+		Update()
+	defer it.Close()
+
+	if err := it.Error(); err != nil {
+		return c.wrapError(op, err)
+	}
+	if it.Count() == 0 {
+		return domain.PreconditionFailed("item %s was modified concurrently", item.ID)
+	}
+
+	return nil
+}
+
+func nestedPath(idx int) string {
+	return fmt.Sprintf("%s[%d]", fieldRelated, idx)
+}
+
+func atomPath(nestedIdx, atomIdx int) string {
+	return fmt.Sprintf("%s.%s[%d]", nestedPath(nestedIdx), fieldRelated, atomIdx)
+}
+
+// formatTime приводит время к виду, в котором оно хранится в документе
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339Nano)
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+)
+
+// connState состояние соединения. ready сбрасывается, когда соединение потеряно, и выставляется снова после
+// переподключения и открытия пространств имен
+type connState struct {
+	ready atomic.Bool
+}
+
+// Ready готов ли клиент обслуживать запросы: пространства имен открыты и Reindexer отвечает на Ping
+func (c Client) Ready(ctx context.Context) bool {
+	return c.state.ready.Load() && c.IsConnected(ctx)
+}
+
+// Supervise проверяет соединение раз в PingInterval, пока не отменен ctx. Потеряв соединение, клиент перестает
+// быть готовым, переподключается с задержками как при запуске, но без ограничения попыток, и заново открывает
+// пространства имен. onChange вызывается при каждой смене готовности с ошибкой, если соединение потеряно
+func (c Client) Supervise(ctx context.Context, onChange func(ready bool, err error)) {
+	if c.connect.PingInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(c.connect.PingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := c.WithContext(ctx).Ping()
+		if err == nil || ctx.Err() != nil {
+			continue
+		}
+		c.state.ready.Store(false)
+		onChange(false, err)
+
+		err = c.retry(ctx, 0, func() error {
+			return c.open(ctx)
+		})
+		if err != nil {
+			// retry без ограничения попыток завершается только отменой ctx
+			return
+		}
+		c.state.ready.Store(true)
+		onChange(true, nil)
+	}
+}
+
+// retry вызывает fn, пока она возвращает ошибку недоступности, но не больше attempts раз, 0 без ограничения.
+// Неудачное подключение cproto возвращает без кода, поэтому недоступность подтверждается еще и Ping.
+// После отмены ctx возвращается ctx.Err(), а не ошибка последней попытки, чтобы остановку можно было отличить от сбоя
+func (c Client) retry(ctx context.Context, attempts int, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err == nil || !unavailable(err) && c.IsConnected(ctx) {
+			return err
+		}
+		if attempts > 0 && attempt >= attempts {
+			return fmt.Errorf("reindexer is unavailable after %d attempts: %w", attempt, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.backoff(attempt)):
+		}
+	}
+}
+
+// backoff задержка после попытки attempt: BackoffBase, удваиваемая с каждой попыткой, но не больше BackoffMax,
+// со случайным отклонением на долю Jitter в обе стороны, чтобы реплики не переподключались одновременно
+func (c Client) backoff(attempt int) time.Duration {
+	delay := c.connect.BackoffBase
+	for i := 1; i < attempt && delay < c.connect.BackoffMax; i++ {
+		delay *= 2
+	}
+	delay = min(delay, c.connect.BackoffMax)
+
+	jitter := min(max(c.connect.Jitter, 0), 1)
+	return time.Duration(float64(delay) * (1 + jitter*(2*rand.Float64()-1)))
+}
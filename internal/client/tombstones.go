@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"github.com/restream/reindexer"
+	"go.opentelemetry.io/otel/attribute"
+	"time"
+)
+
+// Отметки об удалении хранятся ограниченное время. Перед удалением отметок граница синхронизации поднимается
+// до наибольшего номера изменения среди них: токен с номером меньше границы мог пропустить удаленные отметки,
+// и синхронизацию по нему нужно начинать заново
+
+// syncHorizonID ID единственного документа пространства имен состояния синхронизации
+const syncHorizonID = "horizon"
+
+type SyncHorizon struct {
+	ID  string `reindex:"id,,pk"`
+	Seq int64  `reindex:"seq"`
+}
+
+func (c Client) syncNamespace() string {
+	return c.namespace + "_sync"
+}
+
+func (c Client) openSyncNamespace() error {
+	if err := c.OpenNamespace(c.syncNamespace(), reindexer.DefaultNamespaceOptions(), SyncHorizon{}); err != nil {
+		return fmt.Errorf("client.OpenNamespace %s: %w", c.syncNamespace(), err)
+	}
+
+	return nil
+}
+
+// GetChangesHorizon возвращает границу синхронизации, 0 если отметки об удалении еще не удалялись
+func (c Client) GetChangesHorizon(ctx context.Context) (_ int64, err error) {
+	ctx, done := c.observe(ctx, "client.GetChangesHorizon", namespaceAttr(c.syncNamespace()))
+	defer func() { done(err) }()
+
+	it := c.WithContext(ctx).Query(c.syncNamespace()).Where("id", reindexer.EQ, syncHorizonID).Exec()
+	defer it.Close()
+
+	if !it.Next() {
+		if err := it.Error(); err != nil {
+			return 0, c.wrapError("client.GetChangesHorizon", err)
+		}
+		return 0, nil
+	}
+
+	return it.Object().(*SyncHorizon).Seq, nil
+}
+
+// PurgeTombstones удаляет отметки об удалении, сделанные раньше before, и возвращает их количество.
+// Отметки с неразобранными событиями outbox остаются до их разбора
+func (c Client) PurgeTombstones(ctx context.Context, before time.Time) (_ int, err error) {
+	ctx, done := c.observe(ctx, "client.PurgeTombstones", attribute.String("db.reindexer.before", before.Format(time.RFC3339)))
+	defer func() { done(err) }()
+
+	expired := func() *reindexer.Query {
+		return c.WithContext(ctx).Query(c.namespace).
+			Where(indexDeleted, reindexer.EQ, true).
+			Where(indexDeletedAt, reindexer.LT, before.UnixNano()).
+			Where(indexOutbox, reindexer.EMPTY, nil)
+	}
+
+	it := expired().Sort(indexSeq, true).Limit(1).Exec()
+	defer it.Close()
+	if !it.Next() {
+		if err := it.Error(); err != nil {
+			return 0, c.wrapError("client.PurgeTombstones", err)
+		}
+		return 0, nil
+	}
+	horizon := it.Object().(*Item).Seq
+
+	if err := c.raiseHorizon(ctx, horizon); err != nil {
+		return 0, err
+	}
+
+	// отметки новее границы, удаленные за время запроса, остаются до следующего раза
+	count, err := expired().Where(indexSeq, reindexer.LE, horizon).Delete()
+	if err != nil {
+		return 0, c.wrapError("client.PurgeTombstones", err)
+	}
+
+	return count, nil
+}
+
+// raiseHorizon поднимает границу синхронизации до seq. Граница только растет, поэтому реплики,
+// удаляющие отметки одновременно, не опускают ее друг другу
+func (c Client) raiseHorizon(ctx context.Context, seq int64) error {
+	db := c.WithContext(ctx)
+	if _, err := db.Insert(c.syncNamespace(), &SyncHorizon{ID: syncHorizonID, Seq: seq}); err != nil {
+		return c.wrapError("client.raiseHorizon", err)
+	}
+
+	it := db.Query(c.syncNamespace()).
+		Where("id", reindexer.EQ, syncHorizonID).
+		Where("seq", reindexer.LT, seq).
+		Set("seq", seq).
+		Update()
+	defer it.Close()
+	if err := it.Error(); err != nil {
+		return c.wrapError("client.raiseHorizon", err)
+	}
+
+	return nil
+}
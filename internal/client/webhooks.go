@@ -0,0 +1,460 @@
+package client
+
+import (
+	"context"
+	"crud/internal/domain"
+	"fmt"
+	"github.com/gofrs/uuid/v5"
+	"github.com/restream/reindexer"
+	"go.opentelemetry.io/otel/attribute"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Подписки и запланированные доставки вебхуков хранятся в отдельных пространствах имен рядом с документами.
+// Транзакции Reindexer ограничены одним пространством имен, поэтому исходящие события (outbox) хранятся в самом
+// документе, в массиве outbox, и записываются тем же запросом, что и изменение документа, если на них есть подписка.
+// Диспетчер выбирает документы с непустым outbox, планирует доставки и затем удаляет из документа разобранные события
+
+type Webhook struct {
+	ID        string    `reindex:"id,,pk"`
+	URL       string    `reindex:"url"`
+	Secret    string    `reindex:"secret"`
+	Events    []string  `reindex:"events"`
+	CreatedAt time.Time `reindex:"createdAt"`
+}
+
+type Delivery struct {
+	ID            string `reindex:"id,,pk"`
+	WebhookID     string `reindex:"webhookId"`
+	EventID       string `reindex:"eventId"`
+	EventType     string `reindex:"eventType"`
+	ItemID        string `reindex:"itemId"`
+	EventAt       int64  `reindex:"eventAt"`
+	State         string `reindex:"state"`
+	Attempts      int    `reindex:"attempts"`
+	NextAttemptAt int64  `reindex:"nextAttemptAt,tree"`
+	LastError     string `reindex:"lastError"`
+}
+
+func (c Client) webhooksNamespace() string {
+	return c.namespace + "_webhooks"
+}
+
+func (c Client) deliveriesNamespace() string {
+	return c.namespace + "_deliveries"
+}
+
+func (c Client) openWebhookNamespaces() error {
+	namespaces := []struct {
+		name string
+		dto  any
+	}{
+		{c.webhooksNamespace(), Webhook{}},
+		{c.deliveriesNamespace(), Delivery{}},
+	}
+	for _, ns := range namespaces {
+		if err := c.OpenNamespace(ns.name, reindexer.DefaultNamespaceOptions(), ns.dto); err != nil {
+			return fmt.Errorf("client.OpenNamespace %s: %w", ns.name, err)
+		}
+	}
+
+	return nil
+}
+
+func (c Client) CreateWebhook(ctx context.Context, w domain.Webhook) (err error) {
+	ctx, done := c.observe(ctx, "client.CreateWebhook", namespaceAttr(c.webhooksNamespace()), idAttr(w.ID))
+	defer func() { done(err) }()
+
+	events := make([]string, 0, len(w.Events))
+	for _, e := range w.Events {
+		events = append(events, string(e))
+	}
+
+	count, err := c.WithContext(ctx).Insert(c.webhooksNamespace(), &Webhook{
+		ID:        w.ID.String(),
+		URL:       w.URL,
+		Secret:    w.Secret,
+		Events:    events,
+		CreatedAt: w.CreatedAt,
+	})
+	if err != nil {
+		return c.wrapError("client.CreateWebhook", err)
+	}
+	if count == 0 {
+		return domain.Conflict("webhook %s already exists", w.ID)
+	}
+
+	return nil
+}
+
+func (c Client) GetWebhooks(ctx context.Context) (_ []domain.Webhook, err error) {
+	ctx, done := c.observe(ctx, "client.GetWebhooks", namespaceAttr(c.webhooksNamespace()))
+	defer func() { done(err) }()
+
+	it := c.WithContext(ctx).Query(c.webhooksNamespace()).Sort("createdAt", false).Exec()
+	defer it.Close()
+
+	var webhooks []domain.Webhook
+	for it.Next() {
+		webhooks = append(webhooks, it.Object().(*Webhook).toModel())
+	}
+	if err := it.Error(); err != nil {
+		return nil, c.wrapError("client.GetWebhooks", err)
+	}
+
+	return webhooks, nil
+}
+
+func (c Client) GetWebhook(ctx context.Context, id uuid.UUID) (_ domain.Webhook, _ bool, err error) {
+	ctx, done := c.observe(ctx, "client.GetWebhook", namespaceAttr(c.webhooksNamespace()), idAttr(id))
+	defer func() { done(err) }()
+
+	it := c.WithContext(ctx).Query(c.webhooksNamespace()).Where("id", reindexer.EQ, id.String()).Exec()
+	defer it.Close()
+
+	if !it.Next() {
+		if err := it.Error(); err != nil {
+			return domain.Webhook{}, false, c.wrapError("client.GetWebhook", err)
+		}
+		return domain.Webhook{}, false, nil
+	}
+
+	return it.Object().(*Webhook).toModel(), true, nil
+}
+
+// DeleteWebhook удаляет подписку вместе с ее недоставленными событиями
+func (c Client) DeleteWebhook(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, done := c.observe(ctx, "client.DeleteWebhook", namespaceAttr(c.webhooksNamespace()), idAttr(id))
+	defer func() { done(err) }()
+
+	count, err := c.WithContext(ctx).Query(c.webhooksNamespace()).Where("id", reindexer.EQ, id.String()).Delete()
+	if err != nil {
+		return c.wrapError("client.DeleteWebhook", err)
+	}
+	if count == 0 {
+		return domain.NotFound("webhook %s not found", id)
+	}
+
+	_, err = c.WithContext(ctx).Query(c.deliveriesNamespace()).Where("webhookId", reindexer.EQ, id.String()).Delete()
+	if err != nil {
+		return c.wrapError("client.DeleteWebhook", err)
+	}
+
+	return nil
+}
+
+// subscribed сообщает, есть ли подписка на события eventType. Без подписок событие некому доставлять,
+// и оно не записывается в outbox: иначе outbox рос бы там, где его не разбирает диспетчер, например при
+// импорте из командной строки, а отметки об удалении с событиями не удалялись бы. Подписка, созданная
+// одновременно с изменением документа, может не получить его событие
+func (c Client) subscribed(ctx context.Context, eventType domain.EventType) (bool, error) {
+	it := c.WithContext(ctx).Query(c.webhooksNamespace()).
+		Where("events", reindexer.EMPTY, nil).
+		Or().Where("events", reindexer.EQ, string(eventType)).
+		Limit(1).
+		Exec()
+	defer it.Close()
+
+	found := it.Next()
+	if err := it.Error(); err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+// outboxEntry новое событие eventType для outbox документа или пустая строка, если на него нет подписок
+func (c Client) outboxEntry(ctx context.Context, eventType domain.EventType) (string, error) {
+	ok, err := c.subscribed(ctx, eventType)
+	if err != nil || !ok {
+		return "", err
+	}
+
+	return newOutboxEntry(eventType), nil
+}
+
+// newOutboxEntry новое событие об изменении документа в виде элемента outbox
+func newOutboxEntry(eventType domain.EventType) string {
+	id, _ := uuid.NewV4()
+	return formatOutboxEntry(domain.OutboxEvent{
+		ID:        id,
+		ItemEvent: domain.ItemEvent{Type: eventType, At: time.Now()},
+	})
+}
+
+// formatOutboxEntry элемент outbox имеет вид "<ID события>|<тип>|<время в наносекундах>", документ события
+// определяется тем, в каком документе хранится элемент
+func formatOutboxEntry(e domain.OutboxEvent) string {
+	return fmt.Sprintf("%s|%s|%d", e.ID, e.Type, e.At.UnixNano())
+}
+
+func parseOutboxEntry(itemID uuid.UUID, entry string) (domain.OutboxEvent, error) {
+	parts := strings.Split(entry, "|")
+	if len(parts) != 3 {
+		return domain.OutboxEvent{}, fmt.Errorf("malformed outbox entry %q", entry)
+	}
+	id, err := uuid.FromString(parts[0])
+	if err != nil {
+		return domain.OutboxEvent{}, fmt.Errorf("malformed outbox entry %q: %w", entry, err)
+	}
+	at, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return domain.OutboxEvent{}, fmt.Errorf("malformed outbox entry %q: %w", entry, err)
+	}
+
+	return domain.OutboxEvent{
+		ID: id,
+		ItemEvent: domain.ItemEvent{
+			Type:   domain.EventType(parts[1]),
+			ItemID: itemID,
+			At:     time.Unix(0, at),
+		},
+	}, nil
+}
+
+// withOutbox дополняет запрос обновления добавлением события entry в outbox документа, пустое entry пропускается
+func withOutbox(query *reindexer.Query, entry string) *reindexer.Query {
+	if entry == "" {
+		return query
+	}
+
+	return query.SetExpression(indexOutbox, fmt.Sprintf("%s || ['%s']", indexOutbox, entry))
+}
+
+// removeOutbox выражение, удаляющее элемент из outbox документа. События, добавленные после выборки, остаются
+func removeOutbox(entry string) string {
+	return fmt.Sprintf("array_remove(%s, ['%s'])", indexOutbox, entry)
+}
+
+// GetOutbox возвращает события outbox не более чем из limit документов, включая отметки об удалении,
+// в порядке возникновения
+func (c Client) GetOutbox(ctx context.Context, limit int) (_ []domain.OutboxEvent, err error) {
+	ctx, done := c.observe(ctx, "client.GetOutbox", attribute.Int("db.reindexer.limit", limit))
+	defer func() { done(err) }()
+
+	it := c.WithContext(ctx).Query(c.namespace).Where(indexOutbox, reindexer.ANY, nil).Limit(limit).Exec()
+	defer it.Close()
+
+	var events []domain.OutboxEvent
+	for it.Next() {
+		item := it.Object().(*Item)
+		itemID, _ := uuid.FromString(item.ID)
+		for _, entry := range item.Outbox {
+			event, err := parseOutboxEntry(itemID, entry)
+			if err != nil {
+				return nil, fmt.Errorf("client.GetOutbox: %w", err)
+			}
+			events = append(events, event)
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, c.wrapError("client.GetOutbox", err)
+	}
+	slices.SortFunc(events, func(a, b domain.OutboxEvent) int {
+		return a.At.Compare(b.At)
+	})
+
+	return events, nil
+}
+
+// ScheduleDeliveries одной транзакцией сохраняет доставки события и затем удаляет событие из outbox документа.
+// Доставка, которая уже сохранена под тем же ID, не перезаписывается, поэтому повторное планирование того же
+// события после сбоя или другой репликой не создает новых доставок. Если удалить событие не удалось,
+// а доставка уже выполнена, она будет выполнена повторно, поэтому получатели должны учитывать ID события
+func (c Client) ScheduleDeliveries(ctx context.Context, event domain.OutboxEvent, deliveries []domain.Delivery) (err error) {
+	ctx, done := c.observe(ctx, "client.ScheduleDeliveries", namespaceAttr(c.deliveriesNamespace()), attribute.Int("db.reindexer.items", len(deliveries)))
+	defer func() { done(err) }()
+
+	if len(deliveries) > 0 {
+		tx, err := c.WithContext(ctx).BeginTx(c.deliveriesNamespace())
+		if err != nil {
+			return c.wrapError("client.ScheduleDeliveries", err)
+		}
+		for _, d := range deliveries {
+			dto := deliveryToDTO(d)
+			if err := tx.Insert(&dto); err != nil {
+				_ = tx.Rollback()
+				return c.wrapError("client.ScheduleDeliveries", err)
+			}
+		}
+		if err := tx.Commit(); err != nil {
+			return c.wrapError("client.ScheduleDeliveries", err)
+		}
+	}
+
+	entry := formatOutboxEntry(event)
+	it := c.WithContext(ctx).Query(c.namespace).
+		Where("id", reindexer.EQ, event.ItemID.String()).
+		Where(indexOutbox, reindexer.EQ, entry).
+		SetExpression(indexOutbox, removeOutbox(entry)).
+		Update()
+	defer it.Close()
+	if err := it.Error(); err != nil {
+		return c.wrapError("client.ScheduleDeliveries", err)
+	}
+
+	return nil
+}
+
+// GetDueDeliveries возвращает до limit ожидающих доставок, время попытки которых наступило к now
+func (c Client) GetDueDeliveries(ctx context.Context, now time.Time, limit int) (_ []domain.Delivery, err error) {
+	ctx, done := c.observe(ctx, "client.GetDueDeliveries", namespaceAttr(c.deliveriesNamespace()), attribute.Int("db.reindexer.limit", limit))
+	defer func() { done(err) }()
+
+	it := c.WithContext(ctx).Query(c.deliveriesNamespace()).
+		Where("state", reindexer.EQ, string(domain.DeliveryPending)).
+		Where("nextAttemptAt", reindexer.LE, now.UnixNano()).
+		Sort("nextAttemptAt", false).
+		Limit(limit).
+		Exec()
+
+	return c.collectDeliveries("client.GetDueDeliveries", it)
+}
+
+// GetDeliveries возвращает доставки в состоянии state, начиная с самых новых
+func (c Client) GetDeliveries(ctx context.Context, state domain.DeliveryState, pagination domain.Pagination) (_ []domain.Delivery, err error) {
+	ctx, done := c.observe(ctx, "client.GetDeliveries", append(paginationAttrs(pagination), namespaceAttr(c.deliveriesNamespace()), attribute.String("db.reindexer.filter.state", string(state)))...)
+	defer func() { done(err) }()
+
+	it := c.WithContext(ctx).Query(c.deliveriesNamespace()).
+		Where("state", reindexer.EQ, string(state)).
+		Sort("nextAttemptAt", true).
+		Limit(pagination.Limit).
+		Offset(pagination.Offset).
+		Exec()
+
+	return c.collectDeliveries("client.GetDeliveries", it)
+}
+
+func (c Client) GetDelivery(ctx context.Context, id uuid.UUID) (_ domain.Delivery, _ bool, err error) {
+	ctx, done := c.observe(ctx, "client.GetDelivery", namespaceAttr(c.deliveriesNamespace()), idAttr(id))
+	defer func() { done(err) }()
+
+	it := c.WithContext(ctx).Query(c.deliveriesNamespace()).Where("id", reindexer.EQ, id.String()).Exec()
+	deliveries, err := c.collectDeliveries("client.GetDelivery", it)
+	if err != nil || len(deliveries) == 0 {
+		return domain.Delivery{}, false, err
+	}
+
+	return deliveries[0], true, nil
+}
+
+// ClaimDelivery берет ожидающую доставку в работу до until, если с момента выборки ее не взяла другая реплика.
+// Доставка выбрана по времени попытки, поэтому взятие переносит это время на until: если реплика не сохранит
+// результат попытки, после until доставку возьмет другая
+func (c Client) ClaimDelivery(ctx context.Context, d domain.Delivery, until time.Time) (_ bool, err error) {
+	ctx, done := c.observe(ctx, "client.ClaimDelivery", namespaceAttr(c.deliveriesNamespace()), idAttr(d.ID))
+	defer func() { done(err) }()
+
+	it := c.WithContext(ctx).Query(c.deliveriesNamespace()).
+		Where("id", reindexer.EQ, d.ID.String()).
+		Where("state", reindexer.EQ, string(domain.DeliveryPending)).
+		Where("nextAttemptAt", reindexer.EQ, d.NextAttemptAt.UnixNano()).
+		Set("nextAttemptAt", until.UnixNano()).
+		Update()
+	defer it.Close()
+
+	if err := it.Error(); err != nil {
+		return false, c.wrapError("client.ClaimDelivery", err)
+	}
+
+	return it.Count() > 0, nil
+}
+
+func (c Client) SaveDelivery(ctx context.Context, d domain.Delivery) (err error) {
+	ctx, done := c.observe(ctx, "client.SaveDelivery", namespaceAttr(c.deliveriesNamespace()), idAttr(d.ID))
+	defer func() { done(err) }()
+
+	dto := deliveryToDTO(d)
+	if err := c.WithContext(ctx).Upsert(c.deliveriesNamespace(), &dto); err != nil {
+		return c.wrapError("client.SaveDelivery", err)
+	}
+
+	return nil
+}
+
+func (c Client) DeleteDelivery(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, done := c.observe(ctx, "client.DeleteDelivery", namespaceAttr(c.deliveriesNamespace()), idAttr(id))
+	defer func() { done(err) }()
+
+	_, err = c.WithContext(ctx).Query(c.deliveriesNamespace()).Where("id", reindexer.EQ, id.String()).Delete()
+	if err != nil {
+		return c.wrapError("client.DeleteDelivery", err)
+	}
+
+	return nil
+}
+
+func (c Client) collectDeliveries(op string, it *reindexer.Iterator) ([]domain.Delivery, error) {
+	defer it.Close()
+
+	var deliveries []domain.Delivery
+	for it.Next() {
+		deliveries = append(deliveries, it.Object().(*Delivery).toModel())
+	}
+	if err := it.Error(); err != nil {
+		return nil, c.wrapError(op, err)
+	}
+
+	return deliveries, nil
+}
+
+func (w Webhook) toModel() domain.Webhook {
+	id, _ := uuid.FromString(w.ID)
+
+	events := make([]domain.EventType, 0, len(w.Events))
+	for _, e := range w.Events {
+		events = append(events, domain.EventType(e))
+	}
+
+	return domain.Webhook{
+		ID:        id,
+		URL:       w.URL,
+		Secret:    w.Secret,
+		Events:    events,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func (d Delivery) toModel() domain.Delivery {
+	id, _ := uuid.FromString(d.ID)
+	webhookID, _ := uuid.FromString(d.WebhookID)
+
+	eventID, _ := uuid.FromString(d.EventID)
+	itemID, _ := uuid.FromString(d.ItemID)
+
+	return domain.Delivery{
+		ID:        id,
+		WebhookID: webhookID,
+		Event: domain.OutboxEvent{
+			ID: eventID,
+			ItemEvent: domain.ItemEvent{
+				Type:   domain.EventType(d.EventType),
+				ItemID: itemID,
+				At:     time.Unix(0, d.EventAt),
+			},
+		},
+		State:         domain.DeliveryState(d.State),
+		Attempts:      d.Attempts,
+		NextAttemptAt: time.Unix(0, d.NextAttemptAt),
+		LastError:     d.LastError,
+	}
+}
+
+func deliveryToDTO(d domain.Delivery) Delivery {
+	return Delivery{
+		ID:            d.ID.String(),
+		WebhookID:     d.WebhookID.String(),
+		EventID:       d.Event.ID.String(),
+		EventType:     string(d.Event.Type),
+		ItemID:        d.Event.ItemID.String(),
+		EventAt:       d.Event.At.UnixNano(),
+		State:         string(d.State),
+		Attempts:      d.Attempts,
+		NextAttemptAt: d.NextAttemptAt.UnixNano(),
+		LastError:     d.LastError,
+	}
+}
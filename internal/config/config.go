@@ -10,6 +10,50 @@ type DbConfig struct {
 	Port      string `yaml:"port" env:"PORT" env-required:"true"`
 	Name      string `yaml:"name" env:"NAME" env-required:"true"`
 	Namespace string `yaml:"namespace" env:"NAMESPACE" env-default:"items"`
+
+	// Indexes индексы пространства имен документов сверх выводимых из тегов структуры документа,
+	// сверяются с пространством имен при запуске сервера. Задаются только в yaml, по умолчанию DefaultIndexes
+	Indexes []IndexConfig `yaml:"indexes"`
+	// DropUnknownIndexes удалять при сверке индексы, которые не объявлены
+	DropUnknownIndexes bool `yaml:"drop_unknown_indexes" env:"DROP_UNKNOWN_INDEXES" env-default:"false"`
+
+	Connect ConnectConfig `yaml:"connect" env-prefix:"CONNECT_"`
+}
+
+// ConnectConfig параметры подключения к Reindexer. При запуске подключение повторяется до Attempts раз
+// (0 без ограничения) с задержкой от BackoffBase, удваиваемой до BackoffMax и отклоняемой случайно на долю Jitter.
+// После запуска соединение проверяется раз в PingInterval и восстанавливается с теми же задержками,
+// 0 отключает проверку
+type ConnectConfig struct {
+	Attempts     int           `yaml:"attempts" env:"ATTEMPTS" env-default:"10"`
+	BackoffBase  time.Duration `yaml:"backoff_base" env:"BACKOFF_BASE" env-default:"500ms"`
+	BackoffMax   time.Duration `yaml:"backoff_max" env:"BACKOFF_MAX" env-default:"30s"`
+	Jitter       float64       `yaml:"jitter" env:"JITTER" env-default:"0.2"`
+	PingInterval time.Duration `yaml:"ping_interval" env:"PING_INTERVAL" env-default:"5s"`
+}
+
+// IndexConfig объявление индекса. JSONPaths по умолчанию состоит из имени индекса. У составного индекса
+// FieldType равен composite, а JSONPaths перечисляет входящие в него поля
+type IndexConfig struct {
+	Name      string   `yaml:"name"`
+	JSONPaths []string `yaml:"json_paths"`
+	IndexType string   `yaml:"index_type"`
+	FieldType string   `yaml:"field_type"`
+	Array     bool     `yaml:"array"`
+	Sparse    bool     `yaml:"sparse"`
+	Dense     bool     `yaml:"dense"`
+	// Collate правило сравнения строк: none, ascii, utf8, numeric или custom
+	Collate string `yaml:"collate"`
+	// SortOrder порядок символов для Collate custom
+	SortOrder string `yaml:"sort_order"`
+}
+
+// DefaultIndexes индексы, объявленные, если в конфигурации нет своих. Индексы заводит только конфигурация,
+// миграции их не трогают
+func DefaultIndexes() []IndexConfig {
+	return []IndexConfig{
+		{Name: "sorting", JSONPaths: []string{"sort"}, IndexType: "tree", FieldType: "int64"},
+	}
 }
 
 type ServerConfig struct {
@@ -17,15 +61,127 @@ type ServerConfig struct {
 	Port string `yaml:"port" env:"PORT" env-required:"true"`
 }
 
+// GRPCConfig адрес gRPC API, по умолчанию слушает тот же хост, что и HTTP
+type GRPCConfig struct {
+	Host string `yaml:"host" env:"HOST"`
+	Port string `yaml:"port" env:"PORT" env-default:"9090"`
+}
+
+// AdminConfig служебный HTTP сервер с метриками /metrics, отдельный от API
+type AdminConfig struct {
+	Enabled bool   `yaml:"enabled" env:"ENABLED" env-default:"true"`
+	Host    string `yaml:"host" env:"HOST"`
+	Port    string `yaml:"port" env:"PORT" env-default:"9091"`
+}
+
+// ValidationConfig ограничения на размеры документов, 0 отключает проверку
+type ValidationConfig struct {
+	MaxRelated    int `yaml:"max_related" env:"MAX_RELATED" env-default:"100"`
+	MaxAtoms      int `yaml:"max_atoms" env:"MAX_ATOMS" env-default:"100"`
+	MaxNameLength int `yaml:"max_name_length" env:"MAX_NAME_LENGTH" env-default:"255"`
+	MaxBatchSize  int `yaml:"max_batch_size" env:"MAX_BATCH_SIZE" env-default:"1000"`
+}
+
+// EventsConfig параметры ленты событий об изменении документов
+type EventsConfig struct {
+	// ReplaySize количество последних событий, доступных для возобновления подписки по Last-Event-ID
+	ReplaySize int           `yaml:"replay_size" env:"REPLAY_SIZE" env-default:"1000"`
+	Heartbeat  time.Duration `yaml:"heartbeat" env:"HEARTBEAT" env-default:"15s"`
+}
+
+// WebSocketConfig ограничения одного WebSocket соединения, 0 отключает проверку
+type WebSocketConfig struct {
+	MaxSubscriptions int           `yaml:"max_subscriptions" env:"MAX_SUBSCRIPTIONS" env-default:"100"`
+	MaxMessageSize   int64         `yaml:"max_message_size" env:"MAX_MESSAGE_SIZE" env-default:"65536"`
+	WriteTimeout     time.Duration `yaml:"write_timeout" env:"WRITE_TIMEOUT" env-default:"10s"`
+}
+
+// WebhooksConfig параметры доставки вебхуков
+type WebhooksConfig struct {
+	PollInterval time.Duration `yaml:"poll_interval" env:"POLL_INTERVAL" env-default:"1s"`
+	Timeout      time.Duration `yaml:"timeout" env:"TIMEOUT" env-default:"10s"`
+	BatchSize    int           `yaml:"batch_size" env:"BATCH_SIZE" env-default:"100"`
+	Concurrency  int           `yaml:"concurrency" env:"CONCURRENCY" env-default:"4"`
+	MaxAttempts  int           `yaml:"max_attempts" env:"MAX_ATTEMPTS" env-default:"10"`
+	BackoffBase  time.Duration `yaml:"backoff_base" env:"BACKOFF_BASE" env-default:"1s"`
+	BackoffMax   time.Duration `yaml:"backoff_max" env:"BACKOFF_MAX" env-default:"1h"`
+	// Lease срок, на который реплика берет доставку в работу, должен превышать Timeout
+	Lease time.Duration `yaml:"lease" env:"LEASE" env-default:"1m"`
+}
+
+// TombstonesConfig хранение отметок об удалении. Отметки старше Retention удаляются раз в PurgeInterval,
+// клиентам синхронизации, отставшим больше чем на Retention, придется синхронизироваться заново.
+// Нулевой Retention отключает удаление
+type TombstonesConfig struct {
+	Retention     time.Duration `yaml:"retention" env:"RETENTION" env-default:"720h"`
+	PurgeInterval time.Duration `yaml:"purge_interval" env:"PURGE_INTERVAL" env-default:"1h"`
+}
+
+// ImportConfig параметры импорта документов
+type ImportConfig struct {
+	// BatchSize количество документов, записываемых одной транзакцией
+	BatchSize int `yaml:"batch_size" env:"BATCH_SIZE" env-default:"500"`
+}
+
+// MigrationsConfig параметры миграций. Auto применяет недостающие миграции при запуске сервера,
+// LockTTL срок блокировки миграций, LockWait сколько ждать блокировку, занятую другой репликой
+type MigrationsConfig struct {
+	Auto     bool          `yaml:"auto" env:"AUTO" env-default:"true"`
+	LockTTL  time.Duration `yaml:"lock_ttl" env:"LOCK_TTL" env-default:"1m"`
+	LockWait time.Duration `yaml:"lock_wait" env:"LOCK_WAIT" env-default:"5m"`
+}
+
+// HealthConfig параметры проверок состояния. Timeout ограничивает каждую проверку
+type HealthConfig struct {
+	Timeout time.Duration `yaml:"timeout" env:"TIMEOUT" env-default:"2s"`
+}
+
+// TracingConfig параметры трассировки. Exporter: none, stdout или otlp. Endpoint адрес коллектора OTLP по HTTP,
+// пустой берется из стандартных переменных OTEL_EXPORTER_OTLP_*. SampleRatio доля трасс, начатых сервером,
+// трассы с входящим traceparent следуют решению вызывающего
+type TracingConfig struct {
+	Exporter    string  `yaml:"exporter" env:"EXPORTER" env-default:"none"`
+	Endpoint    string  `yaml:"endpoint" env:"ENDPOINT"`
+	Insecure    bool    `yaml:"insecure" env:"INSECURE" env-default:"false"`
+	SampleRatio float64 `yaml:"sample_ratio" env:"SAMPLE_RATIO" env-default:"1"`
+}
+
+// LogConfig параметры логов. Level: debug, info, warn или error. Format: json или text
+type LogConfig struct {
+	Level  string `yaml:"level" env:"LEVEL" env-default:"info"`
+	Format string `yaml:"format" env:"FORMAT" env-default:"json"`
+}
+
+// ShutdownConfig параметры остановки по SIGTERM. DrainDelay сколько после снятия готовности сервер еще
+// принимает запросы, чтобы балансировщик успел исключить экземпляр. Timeout ограничивает остановку целиком,
+// незавершенные к этому сроку соединения закрываются принудительно
+type ShutdownConfig struct {
+	DrainDelay time.Duration `yaml:"drain_delay" env:"DRAIN_DELAY" env-default:"5s"`
+	Timeout    time.Duration `yaml:"timeout" env:"TIMEOUT" env-default:"15s"`
+}
+
 type Config struct {
-	Server ServerConfig `yaml:"server" env-prefix:"SERVER_"`
-	DB     DbConfig     `yaml:"database" env-prefix:"DB_"`
+	Server     ServerConfig     `yaml:"server" env-prefix:"SERVER_"`
+	GRPC       GRPCConfig       `yaml:"grpc" env-prefix:"GRPC_"`
+	Admin      AdminConfig      `yaml:"admin" env-prefix:"ADMIN_"`
+	DB         DbConfig         `yaml:"database" env-prefix:"DB_"`
+	Validation ValidationConfig `yaml:"validation" env-prefix:"VALIDATION_"`
+	Events     EventsConfig     `yaml:"events" env-prefix:"EVENTS_"`
+	WebSocket  WebSocketConfig  `yaml:"websocket" env-prefix:"WS_"`
+	Webhooks   WebhooksConfig   `yaml:"webhooks" env-prefix:"WEBHOOKS_"`
+	Tombstones TombstonesConfig `yaml:"tombstones" env-prefix:"TOMBSTONES_"`
+	Import     ImportConfig     `yaml:"import" env-prefix:"IMPORT_"`
+	Migrations MigrationsConfig `yaml:"migrations" env-prefix:"MIGRATIONS_"`
+	Health     HealthConfig     `yaml:"health" env-prefix:"HEALTH_"`
+	Tracing    TracingConfig    `yaml:"tracing" env-prefix:"TRACING_"`
+	Log        LogConfig        `yaml:"log" env-prefix:"LOG_"`
+	Shutdown   ShutdownConfig   `yaml:"shutdown" env-prefix:"SHUTDOWN_"`
 
 	TTL time.Duration `yaml:"ttl" env:"TTL" env-default:"15m"`
 }
 
 func New() *Config {
-	return &Config{}
+	return &Config{DB: DbConfig{Indexes: DefaultIndexes()}}
 }
 
 // Load загружает переменные из локального yaml файла и далее перезаписывает полученные и записывает отсутствующие значения переменными окружения.
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"encoding/base64"
+	"github.com/gofrs/uuid/v5"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// changeTokenPrefix версия формата токена, токены другой версии отклоняются
+const changeTokenPrefix = "v1:"
+
+// Change запись документа для инкрементальной синхронизации
+type Change struct {
+	// Seq номер изменения, назначаемый хранилищем при каждой записи документа
+	Seq     int64
+	Item    Item
+	Deleted bool
+}
+
+// Tombstone отметка об удалении документа
+type Tombstone struct {
+	ID        uuid.UUID
+	DeletedAt time.Time
+}
+
+// ChangeSet страница изменений после токена синхронизации
+type ChangeSet struct {
+	Items      []Item
+	Tombstones []Tombstone
+	// Next токен для следующего запроса, при отсутствии изменений совпадает с переданным
+	Next    string
+	HasMore bool
+}
+
+// EncodeChangeToken возвращает непрозрачный токен синхронизации, указывающий на изменение с номером seq
+func EncodeChangeToken(seq int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(changeTokenPrefix + strconv.FormatInt(seq, 10)))
+}
+
+// DecodeChangeToken разбирает токен синхронизации, пустой токен означает синхронизацию с начала
+func DecodeChangeToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	invalid := Validation(FieldError{Field: "since", Reason: "is not a valid sync token"})
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, invalid
+	}
+
+	value, ok := strings.CutPrefix(string(raw), changeTokenPrefix)
+	if !ok {
+		return 0, invalid
+	}
+
+	seq, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seq < 0 {
+		return 0, invalid
+	}
+
+	return seq, nil
+}
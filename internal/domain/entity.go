@@ -2,6 +2,7 @@ package domain
 
 import (
 	"github.com/gofrs/uuid/v5"
+	"slices"
 	"time"
 )
 
@@ -18,6 +19,13 @@ func (it Item) Empty() bool {
 	return it.ID == uuid.Nil
 }
 
+// NestedIndex возвращает индекс вложенного документа с заданным ID или -1
+func (it Item) NestedIndex(id uuid.UUID) int {
+	return slices.IndexFunc(it.Related, func(nst Nested) bool {
+		return nst.ID == id
+	})
+}
+
 type Nested struct {
 	ID      uuid.UUID
 	Name    string
@@ -25,11 +33,37 @@ type Nested struct {
 	Related []Atom
 }
 
+// AtomIndex возвращает индекс атома с заданным ID или -1
+func (nst Nested) AtomIndex(id uuid.UUID) int {
+	return slices.IndexFunc(nst.Related, func(a Atom) bool {
+		return a.ID == id
+	})
+}
+
+// ItemResult результат поиска документа при выборке по списку ID
+type ItemResult struct {
+	ID    uuid.UUID
+	Item  Item
+	Found bool
+}
+
 type Atom struct {
 	ID   uuid.UUID
 	Name string
 }
 
+// filtering
+
+// ItemFilter условия отбора документов, нулевые ID в отборе не участвуют
+type ItemFilter struct {
+	// RelatedID документ содержит вложенный документ с этим ID
+	RelatedID uuid.UUID
+	// AtomID документ содержит атом с этим ID
+	AtomID uuid.UUID
+	// ReferencedID документ содержит вложенный документ или атом с этим ID
+	ReferencedID uuid.UUID
+}
+
 // pagination & sorting
 
 type Pagination struct {
@@ -40,6 +74,6 @@ type Pagination struct {
 type SortOrder string
 
 const (
-	_         SortOrder = "ASC"
+	OrderAsc  SortOrder = "ASC"
 	OrderDesc SortOrder = "DESC"
 )
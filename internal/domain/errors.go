@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Виды ошибок предметной области. Проверяются через errors.Is и определяют ответ, который получит клиент
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrConflict           = errors.New("conflict")
+	ErrValidation         = errors.New("validation failed")
+	ErrPreconditionFailed = errors.New("precondition failed")
+	ErrGone               = errors.New("gone")
+	ErrUnavailable        = errors.New("unavailable")
+	ErrCanceled           = errors.New("canceled")
+)
+
+// FieldError описывает нарушение в конкретном поле документа, Field задается путем вида related[2].related[0].id
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
+// Error ошибка предметной области. Detail и Fields предназначены для клиента,
+// причина хранится только для логов и наружу не отдается
+type Error struct {
+	Kind   error
+	Detail string
+	Fields []FieldError
+
+	cause error
+}
+
+func (e *Error) Error() string {
+	msg := e.Kind.Error()
+	if e.Detail != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Detail)
+	}
+	if e.cause != nil {
+		msg = fmt.Sprintf("%s: %s", msg, e.cause)
+	}
+
+	return msg
+}
+
+func (e *Error) Is(target error) bool {
+	return e.Kind == target
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// WithCause сохраняет исходную ошибку, не меняя того, что увидит клиент
+func (e *Error) WithCause(cause error) *Error {
+	e.cause = cause
+	return e
+}
+
+func NotFound(format string, args ...any) *Error {
+	return &Error{Kind: ErrNotFound, Detail: fmt.Sprintf(format, args...)}
+}
+
+func Conflict(format string, args ...any) *Error {
+	return &Error{Kind: ErrConflict, Detail: fmt.Sprintf(format, args...)}
+}
+
+func PreconditionFailed(format string, args ...any) *Error {
+	return &Error{Kind: ErrPreconditionFailed, Detail: fmt.Sprintf(format, args...)}
+}
+
+func Gone(format string, args ...any) *Error {
+	return &Error{Kind: ErrGone, Detail: fmt.Sprintf(format, args...)}
+}
+
+func Unavailable(cause error) *Error {
+	return &Error{Kind: ErrUnavailable, Detail: "storage is temporarily unavailable", cause: cause}
+}
+
+func Canceled(cause error) *Error {
+	return &Error{Kind: ErrCanceled, Detail: "request was canceled", cause: cause}
+}
+
+func Validation(fields ...FieldError) *Error {
+	return &Error{Kind: ErrValidation, Detail: "request contains invalid fields", Fields: fields}
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"github.com/gofrs/uuid/v5"
+	"slices"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventCreated EventType = "created"
+	EventUpdated EventType = "updated"
+	EventDeleted EventType = "deleted"
+)
+
+// ItemEvent уведомление об изменении документа. Само содержимое документа в событие не входит,
+// актуальная версия запрашивается подписчиком при необходимости
+type ItemEvent struct {
+	// Seq возрастающий номер события в пределах процесса
+	Seq    uint64
+	Type   EventType
+	ItemID uuid.UUID
+	At     time.Time
+}
+
+// EventFilter отбор событий для подписчика, пустой список не ограничивает выборку
+type EventFilter struct {
+	ItemIDs []uuid.UUID
+	Types   []EventType
+}
+
+func (f EventFilter) Match(event ItemEvent) bool {
+	if len(f.ItemIDs) > 0 && !slices.Contains(f.ItemIDs, event.ItemID) {
+		return false
+	}
+
+	return len(f.Types) == 0 || slices.Contains(f.Types, event.Type)
+}
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// CheckResult результат одной проверки состояния, Err равен nil у пройденной проверки
+type CheckResult struct {
+	Name    string
+	Err     error
+	Latency time.Duration
+}
+
+// HealthReport результаты проверок состояния в порядке их объявления
+type HealthReport struct {
+	Checks []CheckResult
+}
+
+// Healthy пройдены ли все проверки
+func (r HealthReport) Healthy() bool {
+	for _, c := range r.Checks {
+		if c.Err != nil {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gofrs/uuid/v5"
+	"strings"
+)
+
+// ConflictPolicy поведение импорта, когда документ с таким ID уже существует
+type ConflictPolicy string
+
+const (
+	// ConflictSkip существующий документ не меняется, строка импорта пропускается
+	ConflictSkip ConflictPolicy = "skip"
+	// ConflictOverwrite существующий документ заменяется импортируемым
+	ConflictOverwrite ConflictPolicy = "overwrite"
+	// ConflictFail импорт останавливается на первом конфликте
+	ConflictFail ConflictPolicy = "fail"
+)
+
+func (p ConflictPolicy) Valid() bool {
+	return p == ConflictSkip || p == ConflictOverwrite || p == ConflictFail
+}
+
+// ImportOptions параметры импорта. При DryRun документы проверяются, но не записываются.
+// BatchSize количество документов, записываемых одной транзакцией
+type ImportOptions struct {
+	Policy    ConflictPolicy
+	DryRun    bool
+	BatchSize int
+}
+
+// ImportRecord документ, прочитанный из файла импорта. Line номер строки, с которой начинается запись,
+// Err ошибка разбора записи
+type ImportRecord struct {
+	Line int
+	Item Item
+	Err  error
+}
+
+// ImportStatus итог обработки одной записи импорта
+type ImportStatus string
+
+const (
+	ImportCreated  ImportStatus = "created"
+	ImportUpdated  ImportStatus = "updated"
+	ImportSkipped  ImportStatus = "skipped"
+	ImportRejected ImportStatus = "rejected"
+)
+
+// ImportLine результат по одной записи, Reason заполняется для пропущенных и отклоненных записей
+type ImportLine struct {
+	Line   int
+	ID     uuid.UUID
+	Status ImportStatus
+	Reason string
+}
+
+// ImportReport отчет об импорте. Aborted означает, что импорт остановлен на конфликте
+// и записи после него не обрабатывались
+type ImportReport struct {
+	Accepted int
+	Rejected int
+	Skipped  int
+	DryRun   bool
+	Aborted  bool
+	Lines    []ImportLine
+}
+
+// Add добавляет результат записи в отчет
+func (r *ImportReport) Add(line ImportLine) {
+	switch line.Status {
+	case ImportCreated, ImportUpdated:
+		r.Accepted++
+	case ImportSkipped:
+		r.Skipped++
+	case ImportRejected:
+		r.Rejected++
+	}
+	r.Lines = append(r.Lines, line)
+}
+
+// Reason текст ошибки для отчета. Для ошибок предметной области перечисляются нарушения в полях
+func Reason(err error) string {
+	var domainErr *Error
+	if !errors.As(err, &domainErr) {
+		return err.Error()
+	}
+
+	reasons := make([]string, 0, len(domainErr.Fields)+1)
+	if len(domainErr.Fields) == 0 {
+		reasons = append(reasons, domainErr.Detail)
+	}
+	for _, f := range domainErr.Fields {
+		reasons = append(reasons, fmt.Sprintf("%s %s", f.Field, f.Reason))
+	}
+
+	return strings.Join(reasons, "; ")
+}
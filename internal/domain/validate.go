@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"fmt"
+	"github.com/gofrs/uuid/v5"
+	"unicode/utf8"
+)
+
+// Limits ограничения на размеры документа, нулевое значение отключает соответствующую проверку
+type Limits struct {
+	MaxRelated    int
+	MaxAtoms      int
+	MaxNameLength int
+	MaxBatchSize  int
+}
+
+// Validate проверяет документ целиком и возвращает ошибку ErrValidation со списком всех нарушений
+func Validate(item Item, limits Limits) error {
+	v := validator{limits: limits}
+
+	v.name("name", item.Name)
+	if limits.MaxRelated > 0 && len(item.Related) > limits.MaxRelated {
+		v.add("related", fmt.Sprintf("must contain at most %d documents", limits.MaxRelated))
+	}
+
+	nestedIDs := make(map[uuid.UUID]struct{}, len(item.Related))
+	for i, nst := range item.Related {
+		v.nested(fmt.Sprintf("related[%d]", i), nst, nestedIDs)
+	}
+
+	if len(v.fields) > 0 {
+		return Validation(v.fields...)
+	}
+
+	return nil
+}
+
+// ValidateBatch проверяет список ID для пакетной выборки
+func ValidateBatch(ids []uuid.UUID, limits Limits) error {
+	if len(ids) == 0 {
+		return Validation(FieldError{Field: "ids", Reason: "must not be empty"})
+	}
+
+	if limits.MaxBatchSize > 0 && len(ids) > limits.MaxBatchSize {
+		return Validation(FieldError{Field: "ids", Reason: fmt.Sprintf("must contain at most %d ids", limits.MaxBatchSize)})
+	}
+
+	return nil
+}
+
+type validator struct {
+	limits Limits
+	fields []FieldError
+}
+
+func (v *validator) add(field, reason string) {
+	v.fields = append(v.fields, FieldError{Field: field, Reason: reason})
+}
+
+func (v *validator) name(field, name string) {
+	if name == "" {
+		v.add(field, "must not be empty")
+		return
+	}
+
+	if v.limits.MaxNameLength > 0 && utf8.RuneCountInString(name) > v.limits.MaxNameLength {
+		v.add(field, fmt.Sprintf("must be at most %d characters long", v.limits.MaxNameLength))
+	}
+}
+
+func (v *validator) id(field string, id uuid.UUID, seen map[uuid.UUID]struct{}) {
+	if id == uuid.Nil {
+		v.add(field, "must be a non-nil UUID")
+		return
+	}
+
+	if _, ok := seen[id]; ok {
+		v.add(field, "must be unique")
+		return
+	}
+	seen[id] = struct{}{}
+}
+
+func (v *validator) nested(path string, nst Nested, seen map[uuid.UUID]struct{}) {
+	v.id(path+".id", nst.ID, seen)
+	v.name(path+".name", nst.Name)
+
+	if v.limits.MaxAtoms > 0 && len(nst.Related) > v.limits.MaxAtoms {
+		v.add(path+".related", fmt.Sprintf("must contain at most %d documents", v.limits.MaxAtoms))
+	}
+
+	atomIDs := make(map[uuid.UUID]struct{}, len(nst.Related))
+	for i, atom := range nst.Related {
+		v.atom(fmt.Sprintf("%s.related[%d]", path, i), atom, atomIDs)
+	}
+}
+
+func (v *validator) atom(path string, atom Atom, seen map[uuid.UUID]struct{}) {
+	v.id(path+".id", atom.ID, seen)
+	v.name(path+".name", atom.Name)
+}
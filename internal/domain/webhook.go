@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"fmt"
+	"github.com/gofrs/uuid/v5"
+	"net/url"
+	"time"
+)
+
+// Webhook подписка внешней системы на события об изменении документов
+type Webhook struct {
+	ID  uuid.UUID
+	URL string
+	// Secret ключ подписи HMAC-SHA256 тела запроса
+	Secret string
+	// Events типы событий для доставки, пустой список означает все события
+	Events    []EventType
+	CreatedAt time.Time
+}
+
+func (w Webhook) Accepts(event ItemEvent) bool {
+	return EventFilter{Types: w.Events}.Match(event)
+}
+
+// OutboxEvent событие, сохраненное вместе с изменением документа для последующей доставки через вебхуки
+type OutboxEvent struct {
+	ID uuid.UUID
+	ItemEvent
+}
+
+type DeliveryState string
+
+const (
+	DeliveryPending DeliveryState = "pending"
+	// DeliveryDead доставка исчерпала попытки и ждет ручного повтора
+	DeliveryDead DeliveryState = "dead"
+)
+
+// Delivery доставка одного события одному вебхуку
+type Delivery struct {
+	ID            uuid.UUID
+	WebhookID     uuid.UUID
+	Event         OutboxEvent
+	State         DeliveryState
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+// ValidateWebhook проверяет подписку и возвращает ошибку ErrValidation со списком всех нарушений
+func ValidateWebhook(w Webhook) error {
+	v := validator{}
+
+	if u, err := url.Parse(w.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v.add("url", "must be an absolute http or https URL")
+	}
+	if w.Secret == "" {
+		v.add("secret", "must not be empty")
+	}
+	for i, t := range w.Events {
+		switch t {
+		case EventCreated, EventUpdated, EventDeleted:
+		default:
+			v.add(fmt.Sprintf("events[%d]", i), "has invalid value")
+		}
+	}
+
+	if len(v.fields) > 0 {
+		return Validation(v.fields...)
+	}
+
+	return nil
+}
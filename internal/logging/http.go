@@ -0,0 +1,79 @@
+package logging
+
+import (
+	"context"
+	"github.com/felixge/httpsnoop"
+	"github.com/gofrs/uuid/v5"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// RequestIDHeader заголовок с ID запроса. Принятый от клиента ID сохраняется, иначе назначается новый
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength ID длиннее считается некорректным и заменяется
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// RequestID возвращает ID текущего запроса или пустую строку вне запроса
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// Middleware назначает запросу ID, кладет в контекст логгер с этим ID и записывает строку журнала доступа
+// после ответа, в том числе прерванного паникой. Ответ оборачивается с сохранением Flush и Hijack,
+// от которых зависят выгрузка и WebSocket
+func Middleware(logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(RequestIDHeader)
+			if !validRequestID(id) {
+				newID, _ := uuid.NewV4()
+				id = newID.String()
+			}
+			w.Header().Set(RequestIDHeader, id)
+
+			requestLogger := logger.With("request_id", id)
+			if span := trace.SpanContextFromContext(r.Context()); span.IsValid() {
+				requestLogger = requestLogger.With("trace_id", span.TraceID().String())
+			}
+			ctx := context.WithValue(r.Context(), requestIDKey{}, id)
+			ctx = WithLogger(ctx, requestLogger)
+
+			start := time.Now()
+			m := httpsnoop.Metrics{Code: http.StatusOK}
+			defer func() {
+				requestLogger.LogAttrs(ctx, slog.LevelInfo, "request",
+					slog.String("method", r.Method),
+					slog.String("path", r.URL.Path),
+					slog.Int("status", m.Code),
+					slog.Float64("latency_ms", float64(time.Since(start))/float64(time.Millisecond)),
+					slog.Int64("bytes", m.Written),
+					slog.String("remote", r.RemoteAddr),
+				)
+			}()
+
+			m.CaptureMetrics(w, func(w http.ResponseWriter) {
+				next.ServeHTTP(w, r.WithContext(ctx))
+			})
+		})
+	}
+}
+
+// validRequestID ID запроса от клиента попадает в логи, поэтому допускаются только видимые ASCII символы
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := range len(id) {
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,84 @@
+package metrics
+
+import (
+	"bufio"
+	"context"
+	"github.com/felixge/httpsnoop"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// unknownOperation метка запросов, которые не дошли до обработчика операции: неизвестный путь,
+// ошибка разбора параметров или маршрут вне OpenAPI
+const unknownOperation = "unknown"
+
+type operationKey struct{}
+
+// SetOperation задает имя операции запроса для меток HTTP метрик. Вызывается обработчиком,
+// который знает операцию, внутри Middleware
+func SetOperation(ctx context.Context, operation string) {
+	if op, ok := ctx.Value(operationKey{}).(*string); ok {
+		*op = operation
+	}
+}
+
+// Middleware считает HTTP запросы и их длительность по операциям
+func (m *Metrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		m.httpInFlight.Inc()
+		defer m.httpInFlight.Dec()
+
+		operation := unknownOperation
+		status, wroteHeader := http.StatusOK, false
+		// Flush и Hijack пробрасываются оберткой, от них зависят потоковая выгрузка, лента событий и WebSocket.
+		// Перехваченное соединение считается ответом 101: httpsnoop.CaptureMetrics пропускает коды 1xx
+		ww := httpsnoop.Wrap(w, httpsnoop.Hooks{
+			WriteHeader: func(next httpsnoop.WriteHeaderFunc) httpsnoop.WriteHeaderFunc {
+				return func(code int) {
+					if !wroteHeader {
+						status, wroteHeader = code, true
+					}
+					next(code)
+				}
+			},
+			Write: func(next httpsnoop.WriteFunc) httpsnoop.WriteFunc {
+				return func(b []byte) (int, error) {
+					wroteHeader = true
+					return next(b)
+				}
+			},
+			ReadFrom: func(next httpsnoop.ReadFromFunc) httpsnoop.ReadFromFunc {
+				return func(src io.Reader) (int64, error) {
+					wroteHeader = true
+					return next(src)
+				}
+			},
+			Flush: func(next httpsnoop.FlushFunc) httpsnoop.FlushFunc {
+				return func() {
+					wroteHeader = true
+					next()
+				}
+			},
+			Hijack: func(next httpsnoop.HijackFunc) httpsnoop.HijackFunc {
+				return func() (net.Conn, *bufio.ReadWriter, error) {
+					conn, rw, err := next()
+					if err == nil && !wroteHeader {
+						status, wroteHeader = http.StatusSwitchingProtocols, true
+					}
+					return conn, rw, err
+				}
+			},
+		})
+		// метрики записываются и при панике обработчика, в том числе при прерывании выгрузки
+		defer func() {
+			m.httpRequests.WithLabelValues(operation, r.Method, strconv.Itoa(status)).Inc()
+			m.httpDuration.WithLabelValues(operation, r.Method).Observe(time.Since(start).Seconds())
+		}()
+
+		next.ServeHTTP(ww, r.WithContext(context.WithValue(r.Context(), operationKey{}, &operation)))
+	})
+}
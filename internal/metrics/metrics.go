@@ -0,0 +1,164 @@
+package metrics
+
+import (
+	"crud/internal/domain"
+	"crud/internal/service"
+	"errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"time"
+)
+
+const namespace = "crud"
+
+// Metrics метрики сервера в собственном реестре: HTTP запросы, запросы к Reindexer, кеш документов,
+// преобразование документов и метрики среды выполнения Go. Отдаются обработчиком Handler
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+	httpInFlight prometheus.Gauge
+
+	queryDuration *prometheus.HistogramVec
+	queryErrors   *prometheus.CounterVec
+
+	processItems    prometheus.Counter
+	processDuration prometheus.Histogram
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "HTTP requests by operation, method and status code.",
+		}, []string{"operation", "method", "code"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "HTTP request duration by operation and method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation", "method"}),
+		httpInFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_in_flight",
+			Help:      "HTTP requests being served.",
+		}),
+
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "reindexer",
+			Name:      "query_duration_seconds",
+			Help:      "Reindexer client operation duration.",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"operation"}),
+		queryErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "reindexer",
+			Name:      "query_errors_total",
+			Help:      "Failed Reindexer client operations by error kind.",
+		}, []string{"operation", "kind"}),
+
+		processItems: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "process",
+			Name:      "items_total",
+			Help:      "Documents transformed by processItems workers.",
+		}),
+		processDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "process",
+			Name:      "duration_seconds",
+			Help:      "Duration of a processItems call.",
+			Buckets:   []float64{.0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1},
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests, m.httpDuration, m.httpInFlight,
+		m.queryDuration, m.queryErrors,
+		m.processItems, m.processDuration,
+	)
+
+	return m
+}
+
+// Handler отдает метрики в формате Prometheus
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ObserveQuery реализует client.QueryObserver
+func (m *Metrics) ObserveQuery(op string, duration time.Duration, err error) {
+	m.queryDuration.WithLabelValues(op).Observe(duration.Seconds())
+	if err != nil {
+		m.queryErrors.WithLabelValues(op, errorKind(err)).Inc()
+	}
+}
+
+// ObserveProcess реализует service.ProcessObserver
+func (m *Metrics) ObserveProcess(items int, duration time.Duration) {
+	m.processItems.Add(float64(items))
+	m.processDuration.Observe(duration.Seconds())
+}
+
+// RegisterCache добавляет метрики кеша документов, значения читаются из stats при каждом сборе
+func (m *Metrics) RegisterCache(stats func() service.CacheStats) {
+	counter := func(name, help string, value func(s service.CacheStats) uint64) prometheus.Collector {
+		return prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "cache",
+			Name:      name,
+			Help:      help,
+		}, func() float64 {
+			return float64(value(stats()))
+		})
+	}
+
+	m.registry.MustRegister(
+		counter("hits_total", "Item cache hits.", func(s service.CacheStats) uint64 { return s.Hits }),
+		counter("misses_total", "Item cache misses.", func(s service.CacheStats) uint64 { return s.Misses }),
+		counter("insertions_total", "Item cache insertions.", func(s service.CacheStats) uint64 { return s.Insertions }),
+		counter("evictions_total", "Item cache evictions, including expired and invalidated items.", func(s service.CacheStats) uint64 { return s.Evictions }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "cache",
+			Name:      "items",
+			Help:      "Items in the item cache.",
+		}, func() float64 {
+			return float64(stats().Items)
+		}),
+	)
+}
+
+// errorKind вид ошибки предметной области для метки kind
+func errorKind(err error) string {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return "not_found"
+	case errors.Is(err, domain.ErrConflict):
+		return "conflict"
+	case errors.Is(err, domain.ErrValidation):
+		return "validation"
+	case errors.Is(err, domain.ErrPreconditionFailed):
+		return "precondition_failed"
+	case errors.Is(err, domain.ErrGone):
+		return "gone"
+	case errors.Is(err, domain.ErrUnavailable):
+		return "unavailable"
+	case errors.Is(err, domain.ErrCanceled):
+		return "canceled"
+	default:
+		return "internal"
+	}
+}
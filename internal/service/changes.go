@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"crud/internal/domain"
+	"time"
+)
+
+// GetChanges возвращает документы, созданные или измененные после токена since, и отметки об удалении.
+// Номера изменений назначаются хранилищем под блокировкой записи, поэтому изменение с меньшим номером
+// всегда видно раньше изменения с большим и страницы не пропускают конкурентные записи.
+// Токен старше границы синхронизации отклоняется с ErrGone: удаленные после него отметки уже не вернуть
+func (s Service) GetChanges(ctx context.Context, since string, limit int) (domain.ChangeSet, error) {
+	after, err := domain.DecodeChangeToken(since)
+	if err != nil {
+		return domain.ChangeSet{}, err
+	}
+
+	changes, err := s.db.GetChanges(ctx, after, limit+1)
+	if err != nil {
+		return domain.ChangeSet{}, err
+	}
+
+	// граница читается после изменений: она поднимается до удаления отметок, поэтому отметки,
+	// удаленные во время чтения изменений, не останутся незамеченными
+	if after > 0 {
+		horizon, err := s.db.GetChangesHorizon(ctx)
+		if err != nil {
+			return domain.ChangeSet{}, err
+		}
+		if after < horizon {
+			return domain.ChangeSet{}, domain.Gone("sync token has expired, synchronize from the beginning")
+		}
+	}
+
+	set := domain.ChangeSet{HasMore: len(changes) > limit}
+	if set.HasMore {
+		changes = changes[:limit]
+	}
+
+	next := after
+	items := make([]domain.Item, 0, len(changes))
+	for _, change := range changes {
+		next = change.Seq
+		if !change.Deleted {
+			items = append(items, change.Item)
+			continue
+		}
+
+		var deletedAt time.Time
+		if change.Item.UpdatedAt != nil {
+			deletedAt = *change.Item.UpdatedAt
+		}
+		set.Tombstones = append(set.Tombstones, domain.Tombstone{ID: change.Item.ID, DeletedAt: deletedAt})
+	}
+
+	set.Items, err = s.processItems(ctx, items, prepareItem)
+	if err != nil {
+		return domain.ChangeSet{}, err
+	}
+	set.Next = domain.EncodeChangeToken(next)
+
+	return set, nil
+}
+
+// PurgeTombstones удаляет отметки об удалении старше retention и возвращает их количество
+func (s Service) PurgeTombstones(ctx context.Context, retention time.Duration) (int, error) {
+	return s.db.PurgeTombstones(ctx, time.Now().Add(-retention))
+}
@@ -1,18 +1,86 @@
 package service
 
-import "context"
+import (
+	"context"
+	"crud/internal/domain"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
 
-type client interface {
-	IsConnected(ctx context.Context) bool
+// HealthCheck именованная проверка зависимости, nil означает, что проверка пройдена
+type HealthCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
 }
+
+// checkStartup имя проверки готовности, которая не пройдена до завершения запуска
+const checkStartup = "startup"
+
+var errStarting = errors.New("startup is not completed")
+
+// Checker выполняет проверки параллельно, каждую не дольше timeout. Готовность дополнительно требует,
+// чтобы запуск был отмечен завершенным через SetReady
 type Checker struct {
-	db client
+	checks  []HealthCheck
+	timeout time.Duration
+	started *atomic.Bool
+}
+
+func NewChecker(timeout time.Duration, checks ...HealthCheck) *Checker {
+	return &Checker{checks: checks, timeout: timeout, started: &atomic.Bool{}}
 }
 
-func NewChecker(db client) *Checker {
-	return &Checker{db}
+// SetReady отмечает, что запуск завершен и экземпляр может принимать запросы, или снимает отметку
+func (c Checker) SetReady(ready bool) {
+	c.started.Store(ready)
+}
+
+// Health выполняет все проверки
+func (c Checker) Health(ctx context.Context) domain.HealthReport {
+	results := make([]domain.CheckResult, len(c.checks))
+
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	return domain.HealthReport{Checks: results}
 }
 
+// Readiness выполняет проверки, добавляя к ним проверку завершения запуска. До завершения запуска
+// зависимости не проверяются: пока идут миграции, их результат ничего не говорит
+func (c Checker) Readiness(ctx context.Context) domain.HealthReport {
+	if !c.started.Load() {
+		return domain.HealthReport{Checks: []domain.CheckResult{{Name: checkStartup, Err: errStarting}}}
+	}
+
+	report := c.Health(ctx)
+	report.Checks = append([]domain.CheckResult{{Name: checkStartup}}, report.Checks...)
+
+	return report
+}
+
+// HealthCheck готов ли экземпляр принимать запросы
 func (c Checker) HealthCheck(ctx context.Context) bool {
-	return c.db.IsConnected(ctx)
+	return c.Readiness(ctx).Healthy()
+}
+
+func (c Checker) run(ctx context.Context, check HealthCheck) domain.CheckResult {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	err := check.Check(ctx)
+
+	return domain.CheckResult{Name: check.Name, Err: err, Latency: time.Since(start)}
 }
@@ -0,0 +1,332 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crud/internal/domain"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/gofrs/uuid/v5"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Заголовки запроса доставки. Подпись считается как HMAC-SHA256 секрета вебхука от строки "<timestamp>.<тело>"
+// и передается в виде "sha256=<hex>". Доставка выполняется как минимум один раз, повторы различаются по X-Webhook-Event-Id
+const (
+	headerWebhookEventID   = "X-Webhook-Event-Id"
+	headerWebhookEvent     = "X-Webhook-Event"
+	headerWebhookTimestamp = "X-Webhook-Timestamp"
+	headerWebhookSignature = "X-Webhook-Signature"
+)
+
+type deliveryStore interface {
+	GetWebhooks(ctx context.Context) ([]domain.Webhook, error)
+	GetWebhook(ctx context.Context, id uuid.UUID) (domain.Webhook, bool, error)
+	GetOutbox(ctx context.Context, limit int) ([]domain.OutboxEvent, error)
+	ScheduleDeliveries(ctx context.Context, event domain.OutboxEvent, deliveries []domain.Delivery) error
+	GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.Delivery, error)
+	ClaimDelivery(ctx context.Context, d domain.Delivery, until time.Time) (bool, error)
+	SaveDelivery(ctx context.Context, d domain.Delivery) error
+	DeleteDelivery(ctx context.Context, id uuid.UUID) error
+}
+
+// Значения параметров диспетчера, если они не заданы
+const (
+	defaultDispatcherPollInterval = time.Second
+	defaultDispatcherBatchSize    = 100
+	defaultDispatcherConcurrency  = 4
+	defaultDispatcherMaxAttempts  = 10
+	defaultDispatcherBackoffBase  = time.Second
+	defaultDispatcherBackoffMax   = time.Hour
+	defaultDeliveryLease          = time.Minute
+)
+
+type DispatcherOptions struct {
+	PollInterval time.Duration
+	BatchSize    int
+	Concurrency  int
+	// MaxAttempts количество попыток, после которого доставка попадает в список недоставленных
+	MaxAttempts int
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	// Lease срок, на который реплика берет доставку в работу. Должен превышать время ожидания ответа получателя,
+	// иначе доставку, которая еще выполняется, возьмет другая реплика
+	Lease time.Duration
+}
+
+func (o DispatcherOptions) withDefaults() DispatcherOptions {
+	if o.PollInterval <= 0 {
+		o.PollInterval = defaultDispatcherPollInterval
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = defaultDispatcherBatchSize
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = defaultDispatcherConcurrency
+	}
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = defaultDispatcherMaxAttempts
+	}
+	if o.BackoffBase <= 0 {
+		o.BackoffBase = defaultDispatcherBackoffBase
+	}
+	if o.BackoffMax <= 0 {
+		o.BackoffMax = defaultDispatcherBackoffMax
+	}
+	o.BackoffMax = max(o.BackoffMax, o.BackoffBase)
+	if o.Lease <= 0 {
+		o.Lease = defaultDeliveryLease
+	}
+
+	return o
+}
+
+// webhookPayload тело запроса доставки
+type webhookPayload struct {
+	ID     uuid.UUID        `json:"id"`
+	Type   domain.EventType `json:"type"`
+	ItemID uuid.UUID        `json:"item_id"`
+	Time   time.Time        `json:"time"`
+}
+
+// Dispatcher разбирает outbox на доставки по подпискам и отправляет их с повторами по экспоненциальной задержке.
+// Диспетчеры нескольких реплик работают с одними и теми же доставками: каждую доставку перед попыткой
+// берет в работу только одна из них
+type Dispatcher struct {
+	db     deliveryStore
+	client *http.Client
+	opts   DispatcherOptions
+	logger *slog.Logger
+
+	started atomic.Bool
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+func NewDispatcher(db deliveryStore, client *http.Client, opts DispatcherOptions, logger *slog.Logger) *Dispatcher {
+	return &Dispatcher{
+		db:     db,
+		client: client,
+		opts:   opts.withDefaults(),
+		logger: logger,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+// Run обрабатывает очередь с периодом PollInterval до отмены ctx или вызова Stop
+func (d *Dispatcher) Run(ctx context.Context) {
+	d.started.Store(true)
+	defer close(d.done)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-d.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := time.NewTicker(d.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.fanOut(ctx); err != nil && ctx.Err() == nil {
+			d.logger.Error(err.Error())
+		}
+		if err := d.deliverDue(ctx); err != nil && ctx.Err() == nil {
+			d.logger.Error(err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop прерывает текущие доставки и дожидается завершения Run, но не дольше ctx
+func (d *Dispatcher) Stop(ctx context.Context) {
+	select {
+	case <-d.stop:
+	default:
+		close(d.stop)
+	}
+	if !d.started.Load() {
+		return
+	}
+
+	select {
+	case <-d.done:
+	case <-ctx.Done():
+	}
+}
+
+// fanOut превращает события outbox в доставки для каждой подходящей подписки
+func (d *Dispatcher) fanOut(ctx context.Context) error {
+	events, err := d.db.GetOutbox(ctx, d.opts.BatchSize)
+	if err != nil || len(events) == 0 {
+		return err
+	}
+
+	webhooks, err := d.db.GetWebhooks(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, event := range events {
+		var deliveries []domain.Delivery
+		for _, w := range webhooks {
+			if !w.Accepts(event.ItemEvent) {
+				continue
+			}
+
+			deliveries = append(deliveries, domain.Delivery{
+				// ID определяется событием и подпиской, поэтому одно событие, разобранное дважды, дает одну доставку
+				ID:            uuid.NewV5(event.ID, w.ID.String()),
+				WebhookID:     w.ID,
+				Event:         event,
+				State:         domain.DeliveryPending,
+				NextAttemptAt: now,
+			})
+		}
+
+		if err := d.db.ScheduleDeliveries(ctx, event, deliveries); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) deliverDue(ctx context.Context) error {
+	deliveries, err := d.db.GetDueDeliveries(ctx, time.Now(), d.opts.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	sem := make(chan struct{}, d.opts.Concurrency)
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := d.attempt(ctx, delivery); err != nil && ctx.Err() == nil {
+				d.logger.Error(err.Error(), "delivery", delivery.ID)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// attempt берет доставку в работу и, если ее не взяла другая реплика, выполняет одну попытку и сохраняет ее результат
+func (d *Dispatcher) attempt(ctx context.Context, delivery domain.Delivery) error {
+	claimed, err := d.db.ClaimDelivery(ctx, delivery, time.Now().Add(d.opts.Lease))
+	if err != nil || !claimed {
+		return err
+	}
+
+	w, found, err := d.db.GetWebhook(ctx, delivery.WebhookID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		// подписку удалили после планирования доставки
+		return d.db.DeleteDelivery(ctx, delivery.ID)
+	}
+
+	sendErr := d.send(ctx, w, delivery.Event)
+	if ctx.Err() != nil {
+		// попытка прервана остановкой и не учитывается, доставка возвращается с прежним временем попытки
+		return d.db.SaveDelivery(context.WithoutCancel(ctx), delivery)
+	}
+	if sendErr == nil {
+		return d.db.DeleteDelivery(ctx, delivery.ID)
+	}
+
+	delivery.Attempts++
+	delivery.LastError = sendErr.Error()
+	delivery.NextAttemptAt = time.Now().Add(d.backoff(delivery.Attempts))
+	if delivery.Attempts >= d.opts.MaxAttempts {
+		delivery.State = domain.DeliveryDead
+	}
+
+	return d.db.SaveDelivery(ctx, delivery)
+}
+
+func (d *Dispatcher) send(ctx context.Context, w domain.Webhook, event domain.OutboxEvent) error {
+	body, err := json.Marshal(webhookPayload{
+		ID:     event.ID,
+		Type:   event.Type,
+		ItemID: event.ItemID,
+		Time:   event.At,
+	})
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(headerWebhookEventID, event.ID.String())
+	req.Header.Set(headerWebhookEvent, string(event.Type))
+	req.Header.Set(headerWebhookTimestamp, timestamp)
+	req.Header.Set(headerWebhookSignature, "sha256="+Sign(w.Secret, timestamp, body))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// backoff задержка перед следующей попыткой: BackoffBase, удваиваемая с каждой попыткой, но не больше BackoffMax
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.opts.BackoffBase
+	for i := 1; i < attempts && delay < d.opts.BackoffMax; i++ {
+		delay *= 2
+	}
+
+	return min(delay, d.opts.BackoffMax)
+}
+
+// Sign возвращает подпись тела запроса доставки в шестнадцатеричном виде
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
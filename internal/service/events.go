@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"crud/internal/domain"
+	"github.com/gofrs/uuid/v5"
+	"sync"
+	"time"
+)
+
+// subscriberBufferSize размер очереди событий подписчика. Подписчик, не успевающий разбирать очередь, отключается,
+// чтобы медленный клиент не задерживал запись документов
+const subscriberBufferSize = 64
+
+type subscriber struct {
+	ch     chan domain.ItemEvent
+	filter domain.EventFilter
+}
+
+// broker рассылает события об изменении документов подписчикам внутри процесса
+// и хранит последние события для возобновления подписки
+type broker struct {
+	mu          sync.Mutex
+	seq         uint64
+	history     []domain.ItemEvent
+	next        int
+	subscribers map[*subscriber]struct{}
+}
+
+func newBroker(replaySize int) *broker {
+	return &broker{
+		history:     make([]domain.ItemEvent, 0, max(replaySize, 0)),
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// subscribe регистрирует подписчика. При resume сначала отправляются сохраненные события с номером больше after;
+// если часть из них уже вытеснена из истории, возвращается false
+func (b *broker) subscribe(ctx context.Context, filter domain.EventFilter, resume bool, after uint64) (<-chan domain.ItemEvent, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var replay []domain.ItemEvent
+	complete := true
+	if resume {
+		replay, complete = b.since(after)
+	}
+
+	sub := &subscriber{
+		ch:     make(chan domain.ItemEvent, subscriberBufferSize+len(replay)),
+		filter: filter,
+	}
+	for _, event := range replay {
+		if filter.Match(event) {
+			sub.ch <- event
+		}
+	}
+	b.subscribers[sub] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		b.unsubscribe(sub)
+	}()
+
+	return sub.ch, complete
+}
+
+// since возвращает сохраненные события с номером больше after в порядке публикации
+func (b *broker) since(after uint64) ([]domain.ItemEvent, bool) {
+	if after > b.seq {
+		// номер из другого процесса, история которого недоступна
+		return nil, false
+	}
+
+	ordered := append(b.history[b.next:len(b.history):len(b.history)], b.history[:b.next]...)
+	oldest := b.seq - uint64(len(ordered)) + 1
+	if after+1 < oldest {
+		return ordered, false
+	}
+
+	return ordered[len(ordered)-int(b.seq-after):], true
+}
+
+func (b *broker) unsubscribe(sub *subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscribers[sub]; ok {
+		delete(b.subscribers, sub)
+		close(sub.ch)
+	}
+}
+
+func (b *broker) publish(event domain.ItemEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	event.Seq = b.seq
+	b.remember(event)
+
+	for sub := range b.subscribers {
+		if !sub.filter.Match(event) {
+			continue
+		}
+
+		select {
+		case sub.ch <- event:
+		default:
+			delete(b.subscribers, sub)
+			close(sub.ch)
+		}
+	}
+}
+
+// remember сохраняет событие в кольцевой буфер истории
+func (b *broker) remember(event domain.ItemEvent) {
+	if cap(b.history) == 0 {
+		return
+	}
+
+	if len(b.history) < cap(b.history) {
+		b.history = append(b.history, event)
+		return
+	}
+
+	b.history[b.next] = event
+	b.next = (b.next + 1) % len(b.history)
+}
+
+// Subscribe возвращает канал событий об изменении документов этим экземпляром сервиса, начиная с момента подписки.
+// Канал закрывается при отмене ctx или если подписчик отстал от потока событий
+func (s Service) Subscribe(ctx context.Context, filter domain.EventFilter) <-chan domain.ItemEvent {
+	ch, _ := s.events.subscribe(ctx, filter, false, 0)
+	return ch
+}
+
+// Resume как Subscribe, но сначала отправляет сохраненные события после события с номером after.
+// Если часть пропущенных событий уже не хранится, возвращается false и подписчику следует перечитать данные целиком
+func (s Service) Resume(ctx context.Context, filter domain.EventFilter, after uint64) (<-chan domain.ItemEvent, bool) {
+	return s.events.subscribe(ctx, filter, true, after)
+}
+
+func (s Service) publish(eventType domain.EventType, id uuid.UUID) {
+	s.events.publish(domain.ItemEvent{Type: eventType, ItemID: id, At: time.Now()})
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"crud/internal/domain"
+	"fmt"
+	"github.com/gofrs/uuid/v5"
+	"iter"
+	"slices"
+	"time"
+)
+
+// defaultImportBatchSize размер порции импорта, если он не задан
+const defaultImportBatchSize = 500
+
+// reasonExists причина пропуска или отклонения записи с ID существующего документа
+const reasonExists = "item already exists"
+
+// ImportItems проверяет записи и записывает их порциями по opts.BatchSize документов, каждая порция одной транзакцией.
+// Ошибки чтения и базы прерывают импорт и возвращаются как ошибка вместе с отчетом о порциях, записанных до нее,
+// ошибки отдельных записей попадают в отчет.
+// При политике ConflictFail порция с конфликтом не записывается, записанные ранее порции остаются
+func (s Service) ImportItems(ctx context.Context, records iter.Seq2[domain.ImportRecord, error], opts domain.ImportOptions) (report domain.ImportReport, err error) {
+	report.DryRun = opts.DryRun
+	// отклоненные записи попадают в отчет сразу, принятые после записи порции
+	defer func() {
+		slices.SortFunc(report.Lines, func(a, b domain.ImportLine) int {
+			return cmp.Compare(a.Line, b.Line)
+		})
+	}()
+
+	if !opts.Policy.Valid() {
+		return report, domain.Validation(domain.FieldError{Field: "policy", Reason: "has invalid value"})
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultImportBatchSize
+	}
+
+	// строка первого вхождения каждого ID, повтор документа в одном импорте отклоняется
+	seen := make(map[uuid.UUID]int)
+	batch := make([]domain.ImportRecord, 0, batchSize)
+
+	for rec, err := range records {
+		if err != nil {
+			return report, err
+		}
+
+		if rec.Err == nil {
+			if rec.Item.Empty() {
+				rec.Item.ID, _ = uuid.NewV4()
+			}
+			rec.Err = domain.Validate(rec.Item, s.limits)
+		}
+		if rec.Err == nil {
+			if first, ok := seen[rec.Item.ID]; ok {
+				rec.Err = domain.Conflict("item is already imported at line %d", first)
+			}
+		}
+		if rec.Err != nil {
+			report.Add(domain.ImportLine{Line: rec.Line, ID: rec.Item.ID, Status: domain.ImportRejected, Reason: domain.Reason(rec.Err)})
+			continue
+		}
+		seen[rec.Item.ID] = rec.Line
+
+		batch = append(batch, rec)
+		if len(batch) < batchSize {
+			continue
+		}
+		if err := s.importBatch(ctx, batch, opts, &report); err != nil || report.Aborted {
+			return report, err
+		}
+		batch = batch[:0]
+	}
+
+	if len(batch) > 0 {
+		if err := s.importBatch(ctx, batch, opts, &report); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+func (s Service) importBatch(ctx context.Context, batch []domain.ImportRecord, opts domain.ImportOptions, report *domain.ImportReport) error {
+	ids := make([]uuid.UUID, 0, len(batch))
+	for _, rec := range batch {
+		ids = append(ids, rec.Item.ID)
+	}
+	found, err := s.db.GetItemsByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	existing := make(map[uuid.UUID]domain.Item, len(found))
+	for _, item := range found {
+		existing[item.ID] = item
+	}
+
+	if opts.Policy == domain.ConflictFail {
+		for _, rec := range batch {
+			if _, ok := existing[rec.Item.ID]; ok {
+				rejectBatch(batch, rec, report)
+				return nil
+			}
+		}
+	}
+
+	now := time.Now()
+	var created, updated []domain.Item
+	lines := make([]domain.ImportLine, 0, len(batch))
+	for _, rec := range batch {
+		item := rec.Item
+		item.UpdatedAt = &now
+		line := domain.ImportLine{Line: rec.Line, ID: item.ID}
+
+		old, ok := existing[item.ID]
+		switch {
+		case ok && opts.Policy == domain.ConflictSkip:
+			line.Status, line.Reason = domain.ImportSkipped, reasonExists
+		case ok:
+			if item.CreatedAt.IsZero() {
+				item.CreatedAt = old.CreatedAt
+			}
+			line.Status = domain.ImportUpdated
+			updated = append(updated, item)
+		default:
+			if item.CreatedAt.IsZero() {
+				item.CreatedAt = now
+			}
+			line.Status = domain.ImportCreated
+			created = append(created, item)
+		}
+		lines = append(lines, line)
+	}
+
+	if !opts.DryRun && len(created)+len(updated) > 0 {
+		conflicts, err := s.db.ImportItems(ctx, created, updated)
+		// созданные тоже сбрасываются: документ мог попасть в кеш до импорта, например удаленным
+		for _, item := range created {
+			s.cache.Delete(item.ID)
+		}
+		for _, item := range updated {
+			s.cache.Delete(item.ID)
+		}
+		if err != nil {
+			return err
+		}
+
+		// документ, созданный другим запросом после проверки, не записан и попадает в отчет как существующий
+		lost := make(map[uuid.UUID]bool, len(conflicts))
+		for _, id := range conflicts {
+			lost[id] = true
+		}
+		for i, line := range lines {
+			if line.Status != domain.ImportCreated || !lost[line.ID] {
+				continue
+			}
+			lines[i].Status, lines[i].Reason = domain.ImportRejected, reasonExists
+			if opts.Policy == domain.ConflictSkip {
+				lines[i].Status = domain.ImportSkipped
+			}
+		}
+
+		for _, item := range created {
+			if !lost[item.ID] {
+				s.publish(domain.EventCreated, item.ID)
+			}
+		}
+		for _, item := range updated {
+			s.publish(domain.EventUpdated, item.ID)
+		}
+	}
+
+	for _, line := range lines {
+		report.Add(line)
+	}
+
+	return nil
+}
+
+// rejectBatch отклоняет порцию целиком из-за конфликта в записи conflict и останавливает импорт
+func rejectBatch(batch []domain.ImportRecord, conflict domain.ImportRecord, report *domain.ImportReport) {
+	for _, rec := range batch {
+		reason := reasonExists
+		if rec.Line != conflict.Line {
+			reason = fmt.Sprintf("import aborted by conflict at line %d", conflict.Line)
+		}
+		report.Add(domain.ImportLine{Line: rec.Line, ID: rec.Item.ID, Status: domain.ImportRejected, Reason: reason})
+	}
+	report.Aborted = true
+}
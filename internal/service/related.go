@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"crud/internal/domain"
+	"errors"
+	"github.com/gofrs/uuid/v5"
+	"slices"
+	"time"
+)
+
+// modifyAttempts количество попыток применить изменение вложенных документов при конкурентной записи
+const modifyAttempts = 3
+
+func (s Service) GetRelated(ctx context.Context, itemID uuid.UUID) ([]domain.Nested, error) {
+	item, err := s.getItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.Related, nil
+}
+
+func (s Service) GetNested(ctx context.Context, itemID, nestedID uuid.UUID) (domain.Nested, error) {
+	item, err := s.getItem(ctx, itemID)
+	if err != nil {
+		return domain.Nested{}, err
+	}
+
+	idx, err := nestedIndex(item, nestedID)
+	if err != nil {
+		return domain.Nested{}, err
+	}
+
+	return item.Related[idx], nil
+}
+
+func (s Service) AddNested(ctx context.Context, itemID uuid.UUID, nst domain.Nested) error {
+	return s.modifyItem(ctx, itemID, func(item domain.Item, updatedAt time.Time) error {
+		if item.NestedIndex(nst.ID) >= 0 {
+			return domain.Conflict("nested document %s already exists", nst.ID)
+		}
+
+		item.Related = append(item.Related, nst)
+		if err := domain.Validate(item, s.limits); err != nil {
+			return err
+		}
+
+		return s.db.SetRelated(ctx, item, updatedAt)
+	})
+}
+
+func (s Service) UpdateNested(ctx context.Context, itemID uuid.UUID, nst domain.Nested) error {
+	return s.modifyItem(ctx, itemID, func(item domain.Item, updatedAt time.Time) error {
+		idx, err := nestedIndex(item, nst.ID)
+		if err != nil {
+			return err
+		}
+
+		item.Related = slices.Clone(item.Related)
+		item.Related[idx] = nst
+		if err := domain.Validate(item, s.limits); err != nil {
+			return err
+		}
+
+		return s.db.SetNested(ctx, item, updatedAt, idx)
+	})
+}
+
+func (s Service) DeleteNested(ctx context.Context, itemID, nestedID uuid.UUID) error {
+	return s.modifyItem(ctx, itemID, func(item domain.Item, updatedAt time.Time) error {
+		idx, err := nestedIndex(item, nestedID)
+		if err != nil {
+			return err
+		}
+
+		return s.db.DropNested(ctx, item, updatedAt, idx)
+	})
+}
+
+func (s Service) GetAtoms(ctx context.Context, itemID, nestedID uuid.UUID) ([]domain.Atom, error) {
+	nst, err := s.GetNested(ctx, itemID, nestedID)
+	if err != nil {
+		return nil, err
+	}
+
+	return nst.Related, nil
+}
+
+func (s Service) GetAtom(ctx context.Context, itemID, nestedID, atomID uuid.UUID) (domain.Atom, error) {
+	nst, err := s.GetNested(ctx, itemID, nestedID)
+	if err != nil {
+		return domain.Atom{}, err
+	}
+
+	idx, err := atomIndex(nst, atomID)
+	if err != nil {
+		return domain.Atom{}, err
+	}
+
+	return nst.Related[idx], nil
+}
+
+func (s Service) AddAtom(ctx context.Context, itemID, nestedID uuid.UUID, atom domain.Atom) error {
+	return s.modifyItem(ctx, itemID, func(item domain.Item, updatedAt time.Time) error {
+		nestedIdx, err := nestedIndex(item, nestedID)
+		if err != nil {
+			return err
+		}
+
+		nst := item.Related[nestedIdx]
+		if nst.AtomIndex(atom.ID) >= 0 {
+			return domain.Conflict("atom %s already exists", atom.ID)
+		}
+
+		nst.Related = append(slices.Clone(nst.Related), atom)
+		item.Related = slices.Clone(item.Related)
+		item.Related[nestedIdx] = nst
+		if err := domain.Validate(item, s.limits); err != nil {
+			return err
+		}
+
+		return s.db.SetAtoms(ctx, item, updatedAt, nestedIdx)
+	})
+}
+
+func (s Service) UpdateAtom(ctx context.Context, itemID, nestedID uuid.UUID, atom domain.Atom) error {
+	return s.modifyItem(ctx, itemID, func(item domain.Item, updatedAt time.Time) error {
+		nestedIdx, err := nestedIndex(item, nestedID)
+		if err != nil {
+			return err
+		}
+
+		nst := item.Related[nestedIdx]
+		atomIdx, err := atomIndex(nst, atom.ID)
+		if err != nil {
+			return err
+		}
+
+		nst.Related = slices.Clone(nst.Related)
+		nst.Related[atomIdx] = atom
+		item.Related = slices.Clone(item.Related)
+		item.Related[nestedIdx] = nst
+		if err := domain.Validate(item, s.limits); err != nil {
+			return err
+		}
+
+		return s.db.SetAtom(ctx, item, updatedAt, nestedIdx, atomIdx)
+	})
+}
+
+func (s Service) DeleteAtom(ctx context.Context, itemID, nestedID, atomID uuid.UUID) error {
+	return s.modifyItem(ctx, itemID, func(item domain.Item, updatedAt time.Time) error {
+		nestedIdx, err := nestedIndex(item, nestedID)
+		if err != nil {
+			return err
+		}
+
+		atomIdx, err := atomIndex(item.Related[nestedIdx], atomID)
+		if err != nil {
+			return err
+		}
+
+		return s.db.DropAtom(ctx, item, updatedAt, nestedIdx, atomIdx)
+	})
+}
+
+// getItem возвращает документ с учетом кеша, отсутствие документа считается ошибкой
+func (s Service) getItem(ctx context.Context, id uuid.UUID) (domain.Item, error) {
+	item, found, err := s.GetItem(ctx, id)
+	if err != nil {
+		return item, err
+	}
+	if !found {
+		return item, domain.NotFound("item %s not found", id)
+	}
+
+	return item, nil
+}
+
+// modifyItem применяет изменение к актуальной версии документа из базы и сбрасывает его кеш
+func (s Service) modifyItem(ctx context.Context, id uuid.UUID, modify func(item domain.Item, updatedAt time.Time) error) error {
+	err := s.applyModify(ctx, id, modify)
+	s.cache.Delete(id)
+	if err != nil {
+		return err
+	}
+	s.publish(domain.EventUpdated, id)
+
+	return nil
+}
+
+// applyModify строит изменение заново, если документ успели изменить конкурентно
+func (s Service) applyModify(ctx context.Context, id uuid.UUID, modify func(item domain.Item, updatedAt time.Time) error) error {
+	var err error
+	for range modifyAttempts {
+		item, found, getErr := s.db.GetItem(ctx, id)
+		if getErr != nil {
+			return getErr
+		}
+		if !found {
+			return domain.NotFound("item %s not found", id)
+		}
+
+		err = modify(item, time.Now())
+		if !errors.Is(err, domain.ErrPreconditionFailed) {
+			return err
+		}
+	}
+
+	return domain.Conflict("item %s is being modified concurrently, retry later", id).WithCause(err)
+}
+
+func nestedIndex(item domain.Item, nestedID uuid.UUID) (int, error) {
+	idx := item.NestedIndex(nestedID)
+	if idx < 0 {
+		return idx, domain.NotFound("nested document %s not found in item %s", nestedID, item.ID)
+	}
+
+	return idx, nil
+}
+
+func atomIndex(nst domain.Nested, atomID uuid.UUID) (int, error) {
+	idx := nst.AtomIndex(atomID)
+	if idx < 0 {
+		return idx, domain.NotFound("atom %s not found in nested document %s", atomID, nst.ID)
+	}
+
+	return idx, nil
+}
@@ -3,44 +3,145 @@ package service
 import (
 	"context"
 	"crud/internal/domain"
+	"crud/internal/logging"
+	"errors"
+	"fmt"
 	"github.com/gofrs/uuid/v5"
 	"github.com/jellydator/ttlcache/v3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"iter"
+	"runtime/debug"
 	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
+const tracerName = "crud/internal/service"
+
 type dbClient interface {
 	CreateItem(ctx context.Context, item domain.Item) error
 	GetItem(ctx context.Context, id uuid.UUID) (domain.Item, bool, error)
-	GetItems(ctx context.Context, pagination domain.Pagination, order domain.SortOrder) ([]domain.Item, error)
-	GetItemsCount(ctx context.Context) (int64, error)
+	GetItemsByIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Item, error)
+	GetItems(ctx context.Context, filter domain.ItemFilter, pagination domain.Pagination, order domain.SortOrder) ([]domain.Item, error)
+	GetItemsCount(ctx context.Context, filter domain.ItemFilter) (int64, error)
+	IterateItems(ctx context.Context, filter domain.ItemFilter, order domain.SortOrder) iter.Seq2[domain.Item, error]
+	IterateItemsByChanges(ctx context.Context, filter domain.ItemFilter) iter.Seq2[domain.Item, error]
+	GetChanges(ctx context.Context, after int64, limit int) ([]domain.Change, error)
+	GetChangesHorizon(ctx context.Context) (int64, error)
+	PurgeTombstones(ctx context.Context, before time.Time) (int, error)
 	UpdateItem(ctx context.Context, item domain.Item) error
+	DeleteItem(ctx context.Context, id uuid.UUID) error
+	ImportItems(ctx context.Context, created, updated []domain.Item) (conflicts []uuid.UUID, err error)
+
+	SetRelated(ctx context.Context, item domain.Item, updatedAt time.Time) error
+	SetNested(ctx context.Context, item domain.Item, updatedAt time.Time, idx int) error
+	DropNested(ctx context.Context, item domain.Item, updatedAt time.Time, idx int) error
+	SetAtoms(ctx context.Context, item domain.Item, updatedAt time.Time, nestedIdx int) error
+	SetAtom(ctx context.Context, item domain.Item, updatedAt time.Time, nestedIdx, atomIdx int) error
+	DropAtom(ctx context.Context, item domain.Item, updatedAt time.Time, nestedIdx, atomIdx int) error
+
+	CreateWebhook(ctx context.Context, w domain.Webhook) error
+	GetWebhooks(ctx context.Context) ([]domain.Webhook, error)
+	GetWebhook(ctx context.Context, id uuid.UUID) (domain.Webhook, bool, error)
+	DeleteWebhook(ctx context.Context, id uuid.UUID) error
+	GetDeliveries(ctx context.Context, state domain.DeliveryState, pagination domain.Pagination) ([]domain.Delivery, error)
+	GetDelivery(ctx context.Context, id uuid.UUID) (domain.Delivery, bool, error)
+	SaveDelivery(ctx context.Context, d domain.Delivery) error
 
 	Start(ctx context.Context) error
 	Stop(ctx context.Context)
 }
 
 type Service struct {
-	db    dbClient
-	cache *ttlcache.Cache[uuid.UUID, domain.Item]
+	db     dbClient
+	cache  *ttlcache.Cache[uuid.UUID, domain.Item]
+	limits domain.Limits
+	events *broker
+
+	// cacheRunning работает ли очистка устаревших записей кеша
+	cacheRunning *atomic.Bool
+
+	observer ProcessObserver
+	tracer   trace.Tracer
+}
+
+// ProcessObserver получает число документов и длительность каждого параллельного преобразования документов
+type ProcessObserver interface {
+	ObserveProcess(items int, duration time.Duration)
+}
+
+// CacheStats счетчики кеша документов с момента запуска и текущее число записей
+type CacheStats struct {
+	Hits       uint64
+	Misses     uint64
+	Insertions uint64
+	Evictions  uint64
+	Items      int
 }
 
-func New(db dbClient, ttl time.Duration) *Service {
+// New создает сервис, replaySize количество последних событий, хранимых для возобновления подписки
+func New(db dbClient, ttl time.Duration, limits domain.Limits, replaySize int) *Service {
 	itemCache := ttlcache.New(
 		ttlcache.WithDisableTouchOnHit[uuid.UUID, domain.Item](),
 		ttlcache.WithTTL[uuid.UUID, domain.Item](ttl),
 	)
-	return &Service{db, itemCache}
+	return &Service{
+		db:           db,
+		cache:        itemCache,
+		limits:       limits,
+		events:       newBroker(replaySize),
+		cacheRunning: &atomic.Bool{},
+		tracer:       noop.NewTracerProvider().Tracer(tracerName),
+	}
+}
+
+// SetTracerProvider подключает трассировку: поиск в кеше и преобразование документов
+func (s *Service) SetTracerProvider(tp trace.TracerProvider) {
+	s.tracer = tp.Tracer(tracerName)
+}
+
+// SetObserver подключает наблюдение за преобразованием документов
+func (s *Service) SetObserver(o ProcessObserver) {
+	s.observer = o
+}
+
+// CacheStats возвращает счетчики кеша документов
+func (s Service) CacheStats() CacheStats {
+	m := s.cache.Metrics()
+	return CacheStats{
+		Hits:       m.Hits,
+		Misses:     m.Misses,
+		Insertions: m.Insertions,
+		Evictions:  m.Evictions,
+		Items:      s.cache.Len(),
+	}
 }
 
 func (s Service) Start(ctx context.Context) error {
-	go s.cache.Start()
+	s.cacheRunning.Store(true)
+	go func() {
+		s.cache.Start()
+		s.cacheRunning.Store(false)
+	}()
 	return s.db.Start(ctx)
 }
 
+// CheckCache проверка для Checker: кеш очищается от устаревших записей
+func (s Service) CheckCache(_ context.Context) error {
+	if !s.cacheRunning.Load() {
+		return errors.New("item cache is not running")
+	}
+
+	return nil
+}
+
+// Close останавливает очистку кеша и затем закрывает соединение с базой
 func (s Service) Close(ctx context.Context) {
-	go s.cache.Stop()
+	s.cache.Stop()
 	s.db.Stop(ctx)
 }
 
@@ -52,54 +153,184 @@ func (s Service) CreateItem(ctx context.Context, item domain.Item) (newItemID uu
 	item.CreatedAt = time.Now()
 	item.UpdatedAt = &item.CreatedAt
 
-	return item.ID, s.db.CreateItem(ctx, item)
+	if err := domain.Validate(item, s.limits); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.db.CreateItem(ctx, item); err != nil {
+		return item.ID, err
+	}
+	s.publish(domain.EventCreated, item.ID)
+
+	return item.ID, nil
 }
 
-func (s Service) GetItemsPaginated(ctx context.Context, pagination domain.Pagination) (items []domain.Item, total int64, err error) {
-	items, err = s.db.GetItems(ctx, pagination, domain.OrderDesc)
+func (s Service) GetItemsPaginated(ctx context.Context, filter domain.ItemFilter, pagination domain.Pagination) (items []domain.Item, total int64, err error) {
+	items, err = s.db.GetItems(ctx, filter, pagination, domain.OrderDesc)
 	if err != nil {
 		return items, total, err
 	}
 
-	total, err = s.db.GetItemsCount(ctx)
+	total, err = s.db.GetItemsCount(ctx, filter)
 	if err != nil {
 		return items, total, err
 	}
 
-	return processItems(items, func(item domain.Item) domain.Item {
-		it := domain.Transform(item)
-		slices.SortFunc(it.Related, func(a, b domain.Nested) int {
-			return int(b.Sort - a.Sort)
-		})
+	items, err = s.processItems(ctx, items, prepareItem)
+	if err != nil {
+		return nil, total, err
+	}
+
+	return items, total, nil
+}
 
-		return it
-	}), total, nil
+// ExportItems обходит все документы по фильтру в порядке поля sort, применяя к каждому то же преобразование,
+// что и выдача списка. Документы читаются из базы по мере обхода, кеш не используется
+func (s Service) ExportItems(ctx context.Context, filter domain.ItemFilter, order domain.SortOrder) iter.Seq2[domain.Item, error] {
+	return func(yield func(domain.Item, error) bool) {
+		for item, err := range s.db.IterateItems(ctx, filter, order) {
+			if err != nil {
+				yield(item, err)
+				return
+			}
+			if !yield(prepareItem(item), nil) {
+				return
+			}
+		}
+	}
+}
+
+// ExportRawItems обходит документы по фильтру без преобразования, в порядке изменений
+func (s Service) ExportRawItems(ctx context.Context, filter domain.ItemFilter) iter.Seq2[domain.Item, error] {
+	return s.db.IterateItemsByChanges(ctx, filter)
 }
 
 func (s Service) GetItem(ctx context.Context, id uuid.UUID) (domain.Item, bool, error) {
+	_, span := s.tracer.Start(ctx, "service.cacheLookup", trace.WithAttributes(attribute.String("item.id", id.String())))
 	cached := s.cache.Get(id)
-	if cached != nil && !cached.IsExpired() {
+	hit := cached != nil && !cached.IsExpired()
+	span.SetAttributes(attribute.Bool("cache.hit", hit))
+	span.End()
+	logging.FromContext(ctx).DebugContext(ctx, "item cache lookup", "id", id, "hit", hit)
+	if hit {
 		return cached.Value(), true, nil
 	}
 
 	item, found, err := s.db.GetItem(ctx, id)
-	if err == nil {
+	// отсутствие документа не кешируется: кеш сбрасывается только при изменении и удалении,
+	// и созданный позже документ оставался бы ненайденным до истечения TTL
+	if err == nil && found {
 		s.cache.Set(id, item, ttlcache.DefaultTTL)
 	}
 
 	return item, found, err
 }
 
+// GetItemsByIDs возвращает документы в порядке запрошенных ID. Документы ищутся сначала в кеше,
+// недостающие загружаются из базы одним запросом
+func (s Service) GetItemsByIDs(ctx context.Context, ids []uuid.UUID) ([]domain.ItemResult, error) {
+	if err := domain.ValidateBatch(ids, s.limits); err != nil {
+		return nil, err
+	}
+
+	_, span := s.tracer.Start(ctx, "service.cacheLookup", trace.WithAttributes(attribute.Int("items", len(ids))))
+	found := make(map[uuid.UUID]domain.Item, len(ids))
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	var missed []uuid.UUID
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		cached := s.cache.Get(id)
+		if cached != nil && !cached.IsExpired() {
+			found[id] = cached.Value()
+			continue
+		}
+		missed = append(missed, id)
+	}
+	span.SetAttributes(attribute.Int("cache.hits", len(found)), attribute.Int("cache.misses", len(missed)))
+	span.End()
+	logging.FromContext(ctx).DebugContext(ctx, "item cache lookup", "hits", len(found), "misses", len(missed))
+
+	if len(missed) > 0 {
+		items, err := s.db.GetItemsByIDs(ctx, missed)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			found[item.ID] = item
+			s.cache.Set(item.ID, item, ttlcache.DefaultTTL)
+		}
+	}
+
+	results := make([]domain.ItemResult, 0, len(ids))
+	for _, id := range ids {
+		item, ok := found[id]
+		results = append(results, domain.ItemResult{ID: id, Item: item, Found: ok})
+	}
+
+	return results, nil
+}
+
 func (s Service) UpdateItem(ctx context.Context, id uuid.UUID, item domain.Item) error {
 	item.ID = id
-	return s.db.UpdateItem(ctx, item)
+	updatedAt := time.Now()
+	item.UpdatedAt = &updatedAt
+
+	if err := domain.Validate(item, s.limits); err != nil {
+		return err
+	}
+
+	err := s.db.UpdateItem(ctx, item)
+	// кеш сбрасывается до публикации события, чтобы подписчик получил актуальную версию
+	s.cache.Delete(id)
+	if err != nil {
+		return err
+	}
+	s.publish(domain.EventUpdated, id)
+
+	return nil
+}
+
+func (s Service) DeleteItem(ctx context.Context, id uuid.UUID) error {
+	err := s.db.DeleteItem(ctx, id)
+	s.cache.Delete(id)
+	if err != nil {
+		return err
+	}
+	s.publish(domain.EventDeleted, id)
+
+	return nil
 }
 
-func processItems(items []domain.Item, transform func(item domain.Item) domain.Item) []domain.Item {
-	ch := make(chan struct {
+// prepareItem готовит документ к выдаче: исключает служебные поля и сортирует вложенные документы
+func prepareItem(item domain.Item) domain.Item {
+	it := domain.Transform(item)
+	slices.SortFunc(it.Related, func(a, b domain.Nested) int {
+		return int(b.Sort - a.Sort)
+	})
+
+	return it
+}
+
+// processItems преобразует документы параллельно, по горутине на документ. Паника в преобразовании
+// перехватывается внутри горутины, иначе она завершила бы весь процесс, и возвращается ошибкой
+func (s Service) processItems(ctx context.Context, items []domain.Item, transform func(item domain.Item) domain.Item) ([]domain.Item, error) {
+	if s.observer != nil {
+		defer func(start time.Time) {
+			s.observer.ObserveProcess(len(items), time.Since(start))
+		}(time.Now())
+	}
+
+	type result struct {
 		index int
 		value domain.Item
-	}, len(items))
+		err   error
+	}
+	ch := make(chan result, len(items))
 
 	var wg sync.WaitGroup
 
@@ -108,11 +339,22 @@ func processItems(items []domain.Item, transform func(item domain.Item) domain.I
 		go func(idx int, it domain.Item) {
 			defer wg.Done()
 
-			transformed := transform(it)
-			ch <- struct {
-				index int
-				value domain.Item
-			}{idx, transformed}
+			_, span := s.tracer.Start(ctx, "service.transform", trace.WithAttributes(
+				attribute.String("item.id", it.ID.String()),
+				attribute.Int("item.index", idx),
+			))
+			defer span.End()
+			defer func() {
+				if p := recover(); p != nil {
+					err := fmt.Errorf("service.processItems: transform of item %s panicked: %v", it.ID, p)
+					logging.FromContext(ctx).Error(err.Error(), "stack", string(debug.Stack()))
+					span.RecordError(err)
+					span.SetStatus(codes.Error, "panic")
+					ch <- result{index: idx, err: err}
+				}
+			}()
+
+			ch <- result{index: idx, value: transform(it)}
 		}(i, item)
 	}
 
@@ -121,9 +363,17 @@ func processItems(items []domain.Item, transform func(item domain.Item) domain.I
 		close(ch)
 	}()
 
-	for result := range ch {
-		items[result.index] = result.value
+	var errs []error
+	for res := range ch {
+		if res.err != nil {
+			errs = append(errs, res.err)
+			continue
+		}
+		items[res.index] = res.value
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
 	}
 
-	return items
+	return items, nil
 }
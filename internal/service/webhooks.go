@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"crud/internal/domain"
+	"github.com/gofrs/uuid/v5"
+	"time"
+)
+
+func (s Service) CreateWebhook(ctx context.Context, w domain.Webhook) (domain.Webhook, error) {
+	if err := domain.ValidateWebhook(w); err != nil {
+		return w, err
+	}
+
+	w.ID, _ = uuid.NewV4()
+	w.CreatedAt = time.Now()
+
+	return w, s.db.CreateWebhook(ctx, w)
+}
+
+func (s Service) GetWebhooks(ctx context.Context) ([]domain.Webhook, error) {
+	return s.db.GetWebhooks(ctx)
+}
+
+func (s Service) GetWebhook(ctx context.Context, id uuid.UUID) (domain.Webhook, error) {
+	w, found, err := s.db.GetWebhook(ctx, id)
+	if err != nil {
+		return w, err
+	}
+	if !found {
+		return w, domain.NotFound("webhook %s not found", id)
+	}
+
+	return w, nil
+}
+
+func (s Service) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	return s.db.DeleteWebhook(ctx, id)
+}
+
+// GetDeadLetters возвращает доставки, исчерпавшие попытки
+func (s Service) GetDeadLetters(ctx context.Context, pagination domain.Pagination) ([]domain.Delivery, error) {
+	return s.db.GetDeliveries(ctx, domain.DeliveryDead, pagination)
+}
+
+// RetryDeadLetter возвращает доставку в очередь с обнуленным счетчиком попыток
+func (s Service) RetryDeadLetter(ctx context.Context, id uuid.UUID) error {
+	d, found, err := s.db.GetDelivery(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !found || d.State != domain.DeliveryDead {
+		return domain.NotFound("dead letter %s not found", id)
+	}
+
+	d.State = domain.DeliveryPending
+	d.Attempts = 0
+	d.NextAttemptAt = time.Now()
+
+	return s.db.SaveDelivery(ctx, d)
+}
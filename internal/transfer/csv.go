@@ -0,0 +1,347 @@
+package transfer
+
+import (
+	"crud/internal/domain"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Колонки CSV. Каждая строка описывает один атом, поля документа и вложенного документа повторяются в строках
+// всех его атомов. Вложенный документ без атомов занимает строку с пустыми колонками атома, документ без
+// вложенных строку с пустыми колонками вложенного
+const (
+	ColumnItemID        = "item_id"
+	ColumnItemName      = "item_name"
+	ColumnItemCreatedAt = "item_created_at"
+	ColumnItemUpdatedAt = "item_updated_at"
+	ColumnNestedID      = "nested_id"
+	ColumnNestedName    = "nested_name"
+	ColumnNestedSort    = "nested_sort"
+	ColumnAtomID        = "atom_id"
+	ColumnAtomName      = "atom_name"
+)
+
+// Columns колонки CSV в порядке по умолчанию
+var Columns = []string{
+	ColumnItemID, ColumnItemName, ColumnItemCreatedAt, ColumnItemUpdatedAt,
+	ColumnNestedID, ColumnNestedName, ColumnNestedSort,
+	ColumnAtomID, ColumnAtomName,
+}
+
+// utf8BOM метка порядка байтов, которую добавляют в начало CSV табличные редакторы
+const utf8BOM = "\ufeff"
+
+// DecodeCSV читает документы из CSV с заголовком. Строки одного документа должны идти подряд,
+// номером записи считается первая строка документа. Ошибка чтения или в заголовке завершает обход
+func DecodeCSV(r io.Reader) iter.Seq2[domain.ImportRecord, error] {
+	return func(yield func(domain.ImportRecord, error) bool) {
+		reader := csv.NewReader(r)
+		reader.ReuseRecord = true
+
+		header, err := reader.Read()
+		var parseErr *csv.ParseError
+		switch {
+		case errors.Is(err, io.EOF):
+			yield(domain.ImportRecord{}, headerError("is missing"))
+			return
+		case errors.As(err, &parseErr):
+			yield(domain.ImportRecord{}, headerError(parseErr.Err.Error()))
+			return
+		case err != nil:
+			yield(domain.ImportRecord{}, fmt.Errorf("read csv: %w", err))
+			return
+		}
+		columns, err := csvColumns(header)
+		if err != nil {
+			yield(domain.ImportRecord{}, err)
+			return
+		}
+
+		var group *csvGroup
+		for {
+			row, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			if errors.As(err, &parseErr) {
+				// строка с ошибкой разметки отклоняется отдельно, документ, к которому она относилась, завершается
+				if group != nil && !yield(group.record(), nil) {
+					return
+				}
+				group = nil
+				rec := domain.ImportRecord{
+					Line: parseErr.StartLine,
+					Err:  domain.Validation(domain.FieldError{Field: "line", Reason: parseErr.Err.Error()}),
+				}
+				if !yield(rec, nil) {
+					return
+				}
+				continue
+			}
+			if err != nil {
+				yield(domain.ImportRecord{}, fmt.Errorf("read csv: %w", err))
+				return
+			}
+
+			line, _ := reader.FieldPos(0)
+			itemID := columns.get(row, ColumnItemID)
+			if group == nil || group.itemID != itemID {
+				if group != nil && !yield(group.record(), nil) {
+					return
+				}
+				group = newCSVGroup(line, itemID, columns, row)
+			}
+			group.add(columns, row)
+		}
+
+		if group != nil {
+			yield(group.record(), nil)
+		}
+	}
+}
+
+// headerError ошибка в заголовке делает непригодным весь файл
+func headerError(reason string) error {
+	return domain.Validation(domain.FieldError{Field: "header", Reason: reason})
+}
+
+// csvColumnIndex номера колонок файла по именам
+type csvColumnIndex map[string]int
+
+func csvColumns(header []string) (csvColumnIndex, error) {
+	columns := make(csvColumnIndex, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, utf8BOM)
+		}
+		name = strings.TrimSpace(name)
+		if !slices.Contains(Columns, name) {
+			return nil, headerError(fmt.Sprintf("has unknown column %q", name))
+		}
+		if _, ok := columns[name]; ok {
+			return nil, headerError(fmt.Sprintf("has duplicate column %q", name))
+		}
+		columns[name] = i
+	}
+
+	for _, name := range []string{ColumnItemID, ColumnItemName} {
+		if _, ok := columns[name]; !ok {
+			return nil, headerError(fmt.Sprintf("must contain column %q", name))
+		}
+	}
+
+	return columns, nil
+}
+
+func (c csvColumnIndex) get(row []string, name string) string {
+	i, ok := c[name]
+	if !ok || i >= len(row) {
+		return ""
+	}
+
+	return row[i]
+}
+
+// csvGroup строки одного документа
+type csvGroup struct {
+	line   int
+	itemID string
+	item   domain.Item
+	errs   recordErrors
+	// номера вложенных документов по ID, строки одного вложенного могут идти не подряд
+	nested map[string]int
+}
+
+func newCSVGroup(line int, itemID string, columns csvColumnIndex, row []string) *csvGroup {
+	g := &csvGroup{line: line, itemID: itemID, nested: make(map[string]int)}
+	g.item = domain.Item{
+		ID:      g.errs.required(ColumnItemID, itemID),
+		Name:    columns.get(row, ColumnItemName),
+		Related: []domain.Nested{},
+	}
+	if value := columns.get(row, ColumnItemCreatedAt); value != "" {
+		createdAt, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			g.errs.add(ColumnItemCreatedAt, "has invalid format")
+		}
+		g.item.CreatedAt = createdAt
+	}
+
+	return g
+}
+
+func (g *csvGroup) add(columns csvColumnIndex, row []string) {
+	if name := columns.get(row, ColumnItemName); name != g.item.Name {
+		g.errs.add(ColumnItemName, "must be the same in all rows of the item")
+	}
+
+	nestedID := columns.get(row, ColumnNestedID)
+	if nestedID == "" {
+		if columns.get(row, ColumnAtomID) != "" {
+			g.errs.add(ColumnNestedID, "is required for an atom")
+		}
+		return
+	}
+
+	idx, ok := g.nested[nestedID]
+	if !ok {
+		var sort int64
+		if value := columns.get(row, ColumnNestedSort); value != "" {
+			var err error
+			if sort, err = strconv.ParseInt(value, 10, 64); err != nil {
+				g.errs.add(ColumnNestedSort, "has invalid format")
+			}
+		}
+
+		idx = len(g.item.Related)
+		g.nested[nestedID] = idx
+		g.item.Related = append(g.item.Related, domain.Nested{
+			ID:      g.errs.required(ColumnNestedID, nestedID),
+			Name:    columns.get(row, ColumnNestedName),
+			Sort:    sort,
+			Related: []domain.Atom{},
+		})
+	} else if name := columns.get(row, ColumnNestedName); name != g.item.Related[idx].Name {
+		g.errs.add(ColumnNestedName, "must be the same in all rows of the nested document")
+	}
+
+	if atomID := columns.get(row, ColumnAtomID); atomID != "" {
+		nst := &g.item.Related[idx]
+		nst.Related = append(nst.Related, domain.Atom{
+			ID:   g.errs.required(ColumnAtomID, atomID),
+			Name: columns.get(row, ColumnAtomName),
+		})
+	}
+}
+
+func (g *csvGroup) record() domain.ImportRecord {
+	return domain.ImportRecord{Line: g.line, Item: g.item, Err: g.errs.err()}
+}
+
+// CSVLayout что описывает одна строка выгрузки
+type CSVLayout string
+
+const (
+	// LayoutAtom строка на каждый атом, такой файл читает DecodeCSV
+	LayoutAtom CSVLayout = "atom"
+	// LayoutNested строка на каждый вложенный документ, атомы перечисляются через atomSeparator
+	LayoutNested CSVLayout = "nested"
+)
+
+// atomSeparator разделитель атомов в одной ячейке при LayoutNested
+const atomSeparator = "; "
+
+// CSVEncoder записывает документы в CSV, по несколько строк на документ
+type CSVEncoder struct {
+	columns []string
+	layout  CSVLayout
+	writer  *csv.Writer
+}
+
+// NewCSVEncoder проверяет набор колонок, пустой набор означает все колонки в порядке Columns
+func NewCSVEncoder(w io.Writer, columns []string, layout CSVLayout) (*CSVEncoder, error) {
+	if len(columns) == 0 {
+		columns = Columns
+	}
+
+	var fields []domain.FieldError
+	seen := make(map[string]struct{}, len(columns))
+	for i, column := range columns {
+		if !slices.Contains(Columns, column) {
+			fields = append(fields, domain.FieldError{Field: fmt.Sprintf("columns[%d]", i), Reason: "is unknown"})
+			continue
+		}
+		if _, ok := seen[column]; ok {
+			fields = append(fields, domain.FieldError{Field: fmt.Sprintf("columns[%d]", i), Reason: "must be unique"})
+		}
+		seen[column] = struct{}{}
+	}
+	if layout != LayoutAtom && layout != LayoutNested {
+		fields = append(fields, domain.FieldError{Field: "layout", Reason: "has invalid value"})
+	}
+	if len(fields) > 0 {
+		return nil, domain.Validation(fields...)
+	}
+
+	return &CSVEncoder{columns: columns, layout: layout, writer: csv.NewWriter(w)}, nil
+}
+
+// WriteHeader записывает строку заголовка, при bom перед ней метку порядка байтов
+func (e *CSVEncoder) WriteHeader(bom bool) error {
+	header := slices.Clone(e.columns)
+	if bom {
+		header[0] = utf8BOM + header[0]
+	}
+
+	return e.writer.Write(header)
+}
+
+// Encode записывает строки одного документа
+func (e *CSVEncoder) Encode(item domain.Item) error {
+	values := map[string]string{
+		ColumnItemID:        item.ID.String(),
+		ColumnItemName:      item.Name,
+		ColumnItemCreatedAt: item.CreatedAt.Format(time.RFC3339Nano),
+	}
+	if item.UpdatedAt != nil {
+		values[ColumnItemUpdatedAt] = item.UpdatedAt.Format(time.RFC3339Nano)
+	}
+
+	if len(item.Related) == 0 {
+		return e.write(values)
+	}
+
+	for _, nst := range item.Related {
+		values[ColumnNestedID] = nst.ID.String()
+		values[ColumnNestedName] = nst.Name
+		values[ColumnNestedSort] = strconv.FormatInt(nst.Sort, 10)
+
+		if e.layout == LayoutNested || len(nst.Related) == 0 {
+			ids := make([]string, 0, len(nst.Related))
+			names := make([]string, 0, len(nst.Related))
+			for _, atom := range nst.Related {
+				ids = append(ids, atom.ID.String())
+				names = append(names, atom.Name)
+			}
+			values[ColumnAtomID] = strings.Join(ids, atomSeparator)
+			values[ColumnAtomName] = strings.Join(names, atomSeparator)
+			if err := e.write(values); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, atom := range nst.Related {
+			values[ColumnAtomID] = atom.ID.String()
+			values[ColumnAtomName] = atom.Name
+			if err := e.write(values); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Flush отправляет записанные строки в нижележащий io.Writer
+func (e *CSVEncoder) Flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func (e *CSVEncoder) write(values map[string]string) error {
+	row := make([]string, len(e.columns))
+	for i, column := range e.columns {
+		row[i] = values[column]
+	}
+
+	return e.writer.Write(row)
+}
@@ -0,0 +1,104 @@
+package transfer
+
+import (
+	"bufio"
+	"crud/internal/domain"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+	"time"
+)
+
+// maxImportLineSize наибольший размер строки NDJSON, один документ со всеми вложенными должен в нее помещаться
+const maxImportLineSize = 16 << 20
+
+type importAtom struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type importNested struct {
+	ID      string       `json:"id"`
+	Name    string       `json:"name"`
+	Sort    int64        `json:"sort"`
+	Related []importAtom `json:"related"`
+}
+
+// importItem документ в строке NDJSON в том же виде, что и выдача API, в том числе выгрузка /items/export
+type importItem struct {
+	ID        string         `json:"id"`
+	Name      string         `json:"name"`
+	Sort      int64          `json:"sort"`
+	CreatedAt time.Time      `json:"created_at"`
+	Related   []importNested `json:"related"`
+}
+
+// DecodeNDJSON читает документы по одному на строку, пустые строки пропускаются.
+// Ошибка чтения или слишком длинная строка завершает обход
+func DecodeNDJSON(r io.Reader) iter.Seq2[domain.ImportRecord, error] {
+	return func(yield func(domain.ImportRecord, error) bool) {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(nil, maxImportLineSize)
+
+		for line := 1; scanner.Scan(); line++ {
+			data := scanner.Bytes()
+			if len(strings.TrimSpace(string(data))) == 0 {
+				continue
+			}
+
+			rec := domain.ImportRecord{Line: line}
+			var doc importItem
+			if err := json.Unmarshal(data, &doc); err != nil {
+				rec.Err = domain.Validation(domain.FieldError{Field: "line", Reason: "is not a valid JSON document"})
+			} else {
+				rec.Item, rec.Err = doc.toModel()
+			}
+
+			if !yield(rec, nil) {
+				return
+			}
+		}
+
+		err := scanner.Err()
+		if errors.Is(err, bufio.ErrTooLong) {
+			err = domain.Validation(domain.FieldError{Field: "line", Reason: fmt.Sprintf("must be at most %d bytes long", maxImportLineSize)})
+		} else if err != nil {
+			err = fmt.Errorf("read ndjson: %w", err)
+		}
+		if err != nil {
+			yield(domain.ImportRecord{}, err)
+		}
+	}
+}
+
+func (doc importItem) toModel() (domain.Item, error) {
+	var errs recordErrors
+	item := domain.Item{
+		ID:        errs.optional("id", doc.ID),
+		Name:      doc.Name,
+		Sort:      doc.Sort,
+		CreatedAt: doc.CreatedAt,
+		Related:   make([]domain.Nested, 0, len(doc.Related)),
+	}
+	for i, nst := range doc.Related {
+		path := fmt.Sprintf("related[%d]", i)
+		n := domain.Nested{
+			ID:      errs.required(path+".id", nst.ID),
+			Name:    nst.Name,
+			Sort:    nst.Sort,
+			Related: make([]domain.Atom, 0, len(nst.Related)),
+		}
+		for j, atom := range nst.Related {
+			n.Related = append(n.Related, domain.Atom{
+				ID:   errs.required(fmt.Sprintf("%s.related[%d].id", path, j), atom.ID),
+				Name: atom.Name,
+			})
+		}
+		item.Related = append(item.Related, n)
+	}
+
+	return item, errs.err()
+}
@@ -0,0 +1,51 @@
+// Package transfer разбирает и формирует файлы импорта и выгрузки документов
+package transfer
+
+import (
+	"crud/internal/domain"
+	"github.com/gofrs/uuid/v5"
+)
+
+// recordErrors накапливает ошибки по полям записи
+type recordErrors struct {
+	fields []domain.FieldError
+}
+
+func (p *recordErrors) add(field, reason string) {
+	for _, f := range p.fields {
+		if f.Field == field && f.Reason == reason {
+			return
+		}
+	}
+	p.fields = append(p.fields, domain.FieldError{Field: field, Reason: reason})
+}
+
+func (p *recordErrors) optional(field, value string) uuid.UUID {
+	if value == "" {
+		return uuid.Nil
+	}
+
+	return p.required(field, value)
+}
+
+func (p *recordErrors) required(field, value string) uuid.UUID {
+	if value == "" {
+		p.add(field, "is required")
+		return uuid.Nil
+	}
+
+	id, err := uuid.FromString(value)
+	if err != nil {
+		p.add(field, "has invalid format")
+	}
+
+	return id
+}
+
+func (p *recordErrors) err() error {
+	if len(p.fields) == 0 {
+		return nil
+	}
+
+	return domain.Validation(p.fields...)
+}
@@ -0,0 +1,57 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"crud/internal/client"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *CrudTestSuite) TestBackupRestore() {
+	ctx := context.Background()
+	item := suite.item
+	item.ID, _ = uuid.NewV4()
+	require.NoError(suite.T(), suite.client.CreateItem(ctx, item))
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, item.ID)
+	}()
+
+	var archive bytes.Buffer
+	info, err := suite.client.Backup(ctx, &archive)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.app.Config.DB.Namespace, info.Namespace)
+	assert.Positive(suite.T(), info.Documents)
+
+	verified, err := client.VerifyBackup(bytes.NewReader(archive.Bytes()))
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), info.Checksum, verified.Checksum)
+
+	corrupted := bytes.Clone(archive.Bytes())
+	corrupted[len(corrupted)/2] ^= 0xff
+	_, err = client.VerifyBackup(bytes.NewReader(corrupted))
+	assert.ErrorIs(suite.T(), err, client.ErrBackupCorrupted)
+
+	cfg := suite.app.Config.DB
+	cfg.Namespace += "_restore_test"
+	restored := client.New(cfg)
+	defer func() {
+		_ = restored.DropNamespace(cfg.Namespace)
+		restored.Stop(ctx)
+	}()
+
+	restoredInfo, err := restored.Restore(ctx, bytes.NewReader(archive.Bytes()), client.RestoreOptions{})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), info.Documents, restoredInfo.Documents)
+
+	got, found, err := restored.GetItem(ctx, item.ID)
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), found)
+	assert.Equal(suite.T(), item.Name, got.Name)
+
+	_, err = restored.Restore(ctx, bytes.NewReader(archive.Bytes()), client.RestoreOptions{})
+	assert.Error(suite.T(), err, "restore into a non-empty namespace requires truncate")
+	_, err = restored.Restore(ctx, bytes.NewReader(archive.Bytes()), client.RestoreOptions{Truncate: true})
+	assert.NoError(suite.T(), err)
+}
@@ -0,0 +1,145 @@
+package test
+
+import (
+	"context"
+	"crud/internal/domain"
+	"encoding/json"
+	"github.com/restream/reindexer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/url"
+)
+
+type changeSet struct {
+	Items []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"items"`
+	Tombstones []struct {
+		ID string `json:"id"`
+	} `json:"tombstones"`
+	Next    string `json:"next"`
+	HasMore bool   `json:"has_more"`
+}
+
+func (suite *CrudTestSuite) TestItemChanges() {
+	ctx := context.Background()
+
+	// догоняем текущее состояние, чтобы получить токен
+	token := ""
+	for {
+		set := suite.getChanges(token)
+		token = set.Next
+		if !set.HasMore {
+			break
+		}
+	}
+
+	deletedID, err := suite.app.Srv.CreateItem(ctx, suite.item)
+	require.NoError(suite.T(), err)
+	updatedID, err := suite.app.Srv.CreateItem(ctx, suite.item)
+	require.NoError(suite.T(), err)
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, updatedID)
+	}()
+
+	updated := suite.item
+	updated.Name = "Updated name"
+	require.NoError(suite.T(), suite.app.Srv.UpdateItem(ctx, updatedID, updated))
+	require.NoError(suite.T(), suite.app.Srv.DeleteItem(ctx, deletedID))
+
+	set := suite.getChanges(token)
+	require.Len(suite.T(), set.Items, 1)
+	assert.Equal(suite.T(), updatedID.String(), set.Items[0].ID)
+	assert.Equal(suite.T(), "Updated name", set.Items[0].Name)
+	require.Len(suite.T(), set.Tombstones, 1)
+	assert.Equal(suite.T(), deletedID.String(), set.Tombstones[0].ID)
+	assert.False(suite.T(), set.HasMore)
+
+	empty := suite.getChanges(set.Next)
+	assert.Empty(suite.T(), empty.Items)
+	assert.Empty(suite.T(), empty.Tombstones)
+	assert.Equal(suite.T(), set.Next, empty.Next)
+
+	resRec := suite.execRequest(http.MethodGet, "/items/changes?since=garbage", nil)
+	assert.Equal(suite.T(), http.StatusBadRequest, resRec.Code)
+}
+
+func (suite *CrudTestSuite) getChanges(token string) changeSet {
+	resRec := suite.execRequest(http.MethodGet, "/items/changes?limit=1000&since="+url.QueryEscape(token), nil)
+	require.Equal(suite.T(), http.StatusOK, resRec.Code)
+
+	var set changeSet
+	require.NoError(suite.T(), json.Unmarshal(resRec.Body.Bytes(), &set))
+
+	return set
+}
+
+func (suite *CrudTestSuite) TestCreateOverTombstone() {
+	ctx := context.Background()
+
+	id, err := suite.app.Srv.CreateItem(ctx, suite.item)
+	require.NoError(suite.T(), err)
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, id)
+	}()
+	require.NoError(suite.T(), suite.app.Srv.DeleteItem(ctx, id))
+
+	item := suite.item
+	item.ID = id
+	item.Name = "Recreated"
+	recreatedID, err := suite.app.Srv.CreateItem(ctx, item)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), id, recreatedID)
+
+	got, found, err := suite.app.Srv.GetItem(ctx, id)
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), found)
+	assert.Equal(suite.T(), "Recreated", got.Name)
+
+	_, err = suite.app.Srv.CreateItem(ctx, item)
+	assert.ErrorIs(suite.T(), err, domain.ErrConflict)
+}
+
+func (suite *CrudTestSuite) TestChangesAfterPurge() {
+	ctx := context.Background()
+
+	id, err := suite.app.Srv.CreateItem(ctx, suite.item)
+	require.NoError(suite.T(), err)
+	first := suite.getChanges("")
+	require.NoError(suite.T(), suite.app.Srv.DeleteItem(ctx, id))
+
+	// отметка остается, пока не разобраны события outbox, диспетчер в тестах не запущен
+	events, err := suite.client.GetOutbox(ctx, 10000)
+	require.NoError(suite.T(), err)
+	for _, event := range events {
+		if event.ItemID == id {
+			require.NoError(suite.T(), suite.client.ScheduleDeliveries(ctx, event, nil))
+		}
+	}
+
+	purged, err := suite.app.Srv.PurgeTombstones(ctx, 0)
+	require.NoError(suite.T(), err)
+	assert.Positive(suite.T(), purged)
+
+	resRec := suite.execRequest(http.MethodGet, "/items/changes?since="+url.QueryEscape(first.Next), nil)
+	assert.Equal(suite.T(), http.StatusGone, resRec.Code)
+}
+
+func (suite *CrudTestSuite) TestTombstonePurgedWithoutWebhooks() {
+	ctx := context.Background()
+
+	id, err := suite.app.Srv.CreateItem(ctx, suite.item)
+	require.NoError(suite.T(), err)
+	require.NoError(suite.T(), suite.app.Srv.DeleteItem(ctx, id))
+
+	// без подписок события не попадают в outbox, и отметку можно удалить, не запуская диспетчер
+	_, err = suite.app.Srv.PurgeTombstones(ctx, 0)
+	require.NoError(suite.T(), err)
+
+	it := suite.client.Query(suite.app.Config.DB.Namespace).Where("id", reindexer.EQ, id.String()).Exec()
+	defer it.Close()
+	assert.False(suite.T(), it.Next())
+	require.NoError(suite.T(), it.Error())
+}
@@ -0,0 +1,62 @@
+package test
+
+import (
+	"context"
+	"crud/internal/client"
+	"crud/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"time"
+)
+
+func (suite *CrudTestSuite) TestClientReadiness() {
+	ctx := context.Background()
+	db := client.New(suite.app.Config.DB)
+	assert.False(suite.T(), db.Ready(ctx), "client is not ready before start")
+
+	require.NoError(suite.T(), db.Start(ctx))
+	assert.True(suite.T(), db.Ready(ctx))
+
+	db.Stop(ctx)
+	assert.False(suite.T(), db.Ready(ctx))
+}
+
+func (suite *CrudTestSuite) TestClientStartRetries() {
+	cfg := suite.app.Config.DB
+	cfg.Port = "1"
+	cfg.Connect.Attempts = 3
+	cfg.Connect.BackoffBase = 10 * time.Millisecond
+	cfg.Connect.BackoffMax = 20 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err := client.New(cfg).Start(ctx)
+	require.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "after 3 attempts")
+}
+
+func (suite *CrudTestSuite) TestClientCanceledRequest() {
+	db := client.New(suite.app.Config.DB)
+	require.NoError(suite.T(), db.Start(context.Background()))
+	defer db.Stop(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := db.GetItemsCount(ctx, domain.ItemFilter{})
+	assert.ErrorIs(suite.T(), err, domain.ErrCanceled)
+	assert.NotErrorIs(suite.T(), err, domain.ErrUnavailable)
+}
+
+func (suite *CrudTestSuite) TestClientStartCanceled() {
+	cfg := suite.app.Config.DB
+	cfg.Port = "1"
+	cfg.Connect.Attempts = 0
+	cfg.Connect.BackoffBase = 10 * time.Millisecond
+	cfg.Connect.BackoffMax = 20 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	time.AfterFunc(100*time.Millisecond, cancel)
+	err := client.New(cfg).Start(ctx)
+	assert.ErrorIs(suite.T(), err, context.Canceled)
+}
@@ -14,6 +14,7 @@ import (
 	_ "github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	_ "github.com/stretchr/testify/suite"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -38,6 +39,7 @@ func (suite *CrudTestSuite) SetupSuite() {
 	suite.client = client.New(suite.app.Config.DB)
 
 	randomID, _ := uuid.NewV4()
+	atomID, _ := uuid.NewV4()
 	suite.item = domain.Item{
 		Name: "Test name",
 		Sort: 1,
@@ -46,6 +48,7 @@ func (suite *CrudTestSuite) SetupSuite() {
 				Name: "Test nested name",
 				ID:   randomID,
 				Related: []domain.Atom{{
+					ID:   atomID,
 					Name: "Test atom name",
 				}},
 			},
@@ -57,6 +60,8 @@ func (suite *CrudTestSuite) SetupTest() {
 	ctx := context.Background()
 	_ = suite.app.Srv.Start(ctx)
 	_ = suite.client.Start(ctx)
+	_, _ = suite.client.MigrateUp(ctx, client.MigrateOptions{})
+	suite.app.HealthChecker.SetReady(true)
 }
 
 func (suite *CrudTestSuite) TearDownTest() {
@@ -115,6 +120,184 @@ func (suite *CrudTestSuite) execCreateItemRequest(body *bytes.Reader, headers ma
 	return response
 }
 
+func (suite *CrudTestSuite) TestAddItemValidationError() {
+	reqBody, err := json.Marshal(map[string]interface{}{
+		"name": suite.item.Name,
+		"related": []map[string]interface{}{
+			{
+				"name": "",
+				"id":   suite.item.Related[0].ID.String(),
+				"related": []map[string]string{
+					{"name": "atom", "id": uuid.Nil.String()},
+				},
+			},
+		},
+	})
+	require.NoError(suite.T(), err)
+
+	resRec := suite.execCreateItemRequest(bytes.NewReader(reqBody), nil)
+	assert.Equal(suite.T(), http.StatusBadRequest, resRec.Code)
+
+	var problem struct {
+		InvalidParams []struct {
+			Name string `json:"name"`
+		} `json:"invalid_params"`
+	}
+	err = json.Unmarshal(resRec.Body.Bytes(), &problem)
+	require.NoError(suite.T(), err)
+
+	names := make([]string, 0, len(problem.InvalidParams))
+	for _, p := range problem.InvalidParams {
+		names = append(names, p.Name)
+	}
+	assert.ElementsMatch(suite.T(), []string{"related[0].name", "related[0].related[0].id"}, names)
+}
+
+func (suite *CrudTestSuite) TestGetItemNotFound() {
+	id, _ := uuid.NewV4()
+	request, err := http.NewRequest(http.MethodGet, "/items/"+id.String(), nil)
+	require.NoError(suite.T(), err)
+	response := httptest.NewRecorder()
+
+	suite.app.Server.Handler.ServeHTTP(response, request)
+
+	assert.Equal(suite.T(), http.StatusNotFound, response.Code)
+	assert.Equal(suite.T(), "application/problem+json", response.Header().Get("Content-Type"))
+
+	var problem map[string]interface{}
+	err = json.Unmarshal(response.Body.Bytes(), &problem)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "/problems/not-found", problem["type"])
+	assert.Equal(suite.T(), "/items/"+id.String(), problem["instance"])
+}
+
+func (suite *CrudTestSuite) TestNestedLifecycle() {
+	ctx := context.Background()
+	itemID, err := suite.app.Srv.CreateItem(ctx, suite.item)
+	require.NoError(suite.T(), err)
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, itemID)
+	}()
+
+	nestedID, _ := uuid.NewV4()
+	atomID, _ := uuid.NewV4()
+	reqBody, err := json.Marshal(map[string]interface{}{
+		"id":   nestedID.String(),
+		"name": "Added nested name",
+		"related": []map[string]string{
+			{"id": atomID.String(), "name": "Added atom name"},
+		},
+	})
+	require.NoError(suite.T(), err)
+
+	nestedURL := "/items/" + itemID.String() + "/related/" + nestedID.String()
+	resRec := suite.execRequest(http.MethodPost, "/items/"+itemID.String()+"/related", bytes.NewReader(reqBody))
+	require.Equal(suite.T(), http.StatusOK, resRec.Code)
+
+	resRec = suite.execRequest(http.MethodPost, "/items/"+itemID.String()+"/related", bytes.NewReader(reqBody))
+	assert.Equal(suite.T(), http.StatusConflict, resRec.Code)
+
+	resRec = suite.execRequest(http.MethodPut, nestedURL+"/related/"+atomID.String(), bytes.NewReader([]byte(`{"name":"Renamed atom"}`)))
+	require.Equal(suite.T(), http.StatusOK, resRec.Code)
+
+	item, ok, err := suite.client.GetItem(ctx, itemID)
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), ok)
+	require.Len(suite.T(), item.Related, 2)
+	assert.Equal(suite.T(), "Renamed atom", item.Related[1].Related[0].Name)
+
+	resRec = suite.execRequest(http.MethodDelete, nestedURL, nil)
+	require.Equal(suite.T(), http.StatusNoContent, resRec.Code)
+
+	resRec = suite.execRequest(http.MethodGet, nestedURL, nil)
+	assert.Equal(suite.T(), http.StatusNotFound, resRec.Code)
+}
+
+func (suite *CrudTestSuite) TestFindItemsByRelatedID() {
+	ctx := context.Background()
+	itemID, err := suite.app.Srv.CreateItem(ctx, suite.item)
+	require.NoError(suite.T(), err)
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, itemID)
+	}()
+
+	nested := suite.item.Related[0]
+	for _, url := range []string{
+		"/items?limit=10&offset=0&related_id=" + nested.ID.String(),
+		"/items?limit=10&offset=0&atom_id=" + nested.Related[0].ID.String(),
+		"/related/" + nested.Related[0].ID.String() + "/items?limit=10&offset=0",
+	} {
+		resRec := suite.execRequest(http.MethodGet, url, nil)
+		require.Equal(suite.T(), http.StatusOK, resRec.Code, url)
+
+		var list struct {
+			Items []struct {
+				ID string `json:"id"`
+			} `json:"items"`
+		}
+		err = json.Unmarshal(resRec.Body.Bytes(), &list)
+		require.NoError(suite.T(), err)
+
+		ids := make([]string, 0, len(list.Items))
+		for _, it := range list.Items {
+			ids = append(ids, it.ID)
+		}
+		assert.Contains(suite.T(), ids, itemID.String(), url)
+	}
+}
+
+func (suite *CrudTestSuite) TestBatchGetItems() {
+	ctx := context.Background()
+	itemID, err := suite.app.Srv.CreateItem(ctx, suite.item)
+	require.NoError(suite.T(), err)
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, itemID)
+	}()
+
+	// отсутствующий документ, запрошенный по одному, не должен оказаться найденным в пакете
+	missingID, _ := uuid.NewV4()
+	resRec := suite.execRequest(http.MethodGet, "/items/"+missingID.String(), nil)
+	require.Equal(suite.T(), http.StatusNotFound, resRec.Code)
+
+	resRec = suite.execRequest(http.MethodGet, "/items?ids="+missingID.String()+","+itemID.String(), nil)
+	require.Equal(suite.T(), http.StatusOK, resRec.Code)
+
+	var batch struct {
+		Items []struct {
+			ID string `json:"id"`
+		} `json:"items"`
+		Results []struct {
+			ID    string `json:"id"`
+			Found bool   `json:"found"`
+		} `json:"results"`
+	}
+	err = json.Unmarshal(resRec.Body.Bytes(), &batch)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), batch.Results, 2)
+	assert.Equal(suite.T(), missingID.String(), batch.Results[0].ID)
+	assert.False(suite.T(), batch.Results[0].Found)
+	assert.Equal(suite.T(), itemID.String(), batch.Results[1].ID)
+	assert.True(suite.T(), batch.Results[1].Found)
+	require.Len(suite.T(), batch.Items, 1)
+	assert.Equal(suite.T(), itemID.String(), batch.Items[0].ID)
+
+	resRec = suite.execRequest(http.MethodGet, "/items?limit=10&ids="+itemID.String(), nil)
+	assert.Equal(suite.T(), http.StatusBadRequest, resRec.Code)
+	resRec = suite.execRequest(http.MethodGet, "/items?limit=10", nil)
+	assert.Equal(suite.T(), http.StatusBadRequest, resRec.Code)
+}
+
+func (suite *CrudTestSuite) execRequest(method, url string, body io.Reader) *httptest.ResponseRecorder {
+	request, err := http.NewRequest(method, url, body)
+	require.NoError(suite.T(), err)
+	request.Header.Set("Content-Type", "application/json")
+	response := httptest.NewRecorder()
+
+	suite.app.Server.Handler.ServeHTTP(response, request)
+
+	return response
+}
+
 //TODO: write other tests
 
 func TestItemCRUDTestSuite(t *testing.T) {
@@ -0,0 +1,96 @@
+package test
+
+import (
+	"bufio"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+)
+
+type sseEvent struct {
+	id    string
+	event string
+	data  string
+}
+
+func (suite *CrudTestSuite) TestItemEventsResume() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv := httptest.NewServer(suite.app.Server.Handler)
+	defer srv.Close()
+
+	events := suite.openEvents(ctx, srv.URL+"/items/events?types=created", "")
+
+	firstID, err := suite.app.Srv.CreateItem(ctx, suite.item)
+	require.NoError(suite.T(), err)
+	secondID, err := suite.app.Srv.CreateItem(ctx, suite.item)
+	require.NoError(suite.T(), err)
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, firstID)
+		_ = suite.client.DeleteItem(ctx, secondID)
+	}()
+
+	first := readEvent(suite, events)
+	assert.Equal(suite.T(), "created", first.event)
+	assert.Contains(suite.T(), first.data, firstID.String())
+
+	// повторное подключение с Last-Event-ID получает пропущенное событие из истории
+	resumed := suite.openEvents(ctx, srv.URL+"/items/events", first.id)
+	second := readEvent(suite, resumed)
+	assert.Contains(suite.T(), second.data, secondID.String())
+
+	assert.Greater(suite.T(), mustParseSeq(suite, second.id), mustParseSeq(suite, first.id))
+}
+
+func (suite *CrudTestSuite) TestItemEventsInvalidType() {
+	resRec := suite.execRequest(http.MethodGet, "/items/events?types=archived", nil)
+	assert.Equal(suite.T(), http.StatusBadRequest, resRec.Code)
+}
+
+func (suite *CrudTestSuite) openEvents(ctx context.Context, url, lastEventID string) *bufio.Reader {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	require.NoError(suite.T(), err)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), http.StatusOK, res.StatusCode)
+	suite.T().Cleanup(func() {
+		_ = res.Body.Close()
+	})
+
+	return bufio.NewReader(res.Body)
+}
+
+// readEvent читает следующее событие, пропуская комментарии
+func readEvent(suite *CrudTestSuite, r *bufio.Reader) sseEvent {
+	var ev sseEvent
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(suite.T(), err)
+
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "" && ev.event != "":
+			return ev
+		case strings.HasPrefix(line, "id: "):
+			ev.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			ev.event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			ev.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func mustParseSeq(suite *CrudTestSuite, id string) uint64 {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	require.NoError(suite.T(), err)
+	return seq
+}
@@ -0,0 +1,143 @@
+package test
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crud/internal/domain"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+type exportedItem struct {
+	ID      string `json:"id"`
+	Related []struct {
+		Name string `json:"name"`
+	} `json:"related"`
+}
+
+func (suite *CrudTestSuite) TestExportItems() {
+	ctx := context.Background()
+	item := suite.item
+	item.Related = []domain.Nested{{ID: uuid.Must(uuid.NewV4()), Name: "Nested"}}
+	itemID, err := suite.app.Srv.CreateItem(ctx, item)
+	require.NoError(suite.T(), err)
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, itemID)
+	}()
+
+	filter := "related_id=" + item.Related[0].ID.String()
+
+	resRec := suite.execRequest(http.MethodGet, "/items/export?"+filter, nil)
+	require.Equal(suite.T(), http.StatusOK, resRec.Code)
+	assert.Equal(suite.T(), "application/x-ndjson", resRec.Header().Get("Content-Type"))
+
+	var items []exportedItem
+	for _, line := range readLines(suite.T(), resRec.Body) {
+		var item exportedItem
+		require.NoError(suite.T(), json.Unmarshal(line, &item))
+		items = append(items, item)
+	}
+	require.Len(suite.T(), items, 1)
+	assert.Equal(suite.T(), itemID.String(), items[0].ID)
+	require.Len(suite.T(), items[0].Related, 1)
+
+	request := httptest.NewRequest(http.MethodGet, "/items/export?mode=raw&"+filter, nil)
+	request.Header.Set("Accept-Encoding", "gzip, deflate")
+	resRec = httptest.NewRecorder()
+	suite.app.Server.Handler.ServeHTTP(resRec, request)
+	require.Equal(suite.T(), http.StatusOK, resRec.Code)
+	require.Equal(suite.T(), "gzip", resRec.Header().Get("Content-Encoding"))
+
+	body, err := gzip.NewReader(resRec.Body)
+	require.NoError(suite.T(), err)
+	lines := readLines(suite.T(), body)
+	require.Len(suite.T(), lines, 1)
+
+	// служебные поля хранимого документа в выгрузку не попадают
+	var doc map[string]any
+	require.NoError(suite.T(), json.Unmarshal(lines[0], &doc))
+	assert.Equal(suite.T(), itemID.String(), doc["id"])
+	assert.Contains(suite.T(), doc, "created_at")
+	for _, field := range []string{"Seq", "Deleted", "DeletedAt", "Outbox", "CreatedAt"} {
+		assert.NotContains(suite.T(), doc, field)
+	}
+
+	// выгрузка загружается обратно импортом без изменений
+	report := suite.importItems("policy=overwrite", "application/x-ndjson", string(lines[0])+"\n")
+	assert.Equal(suite.T(), 1, report.Accepted)
+	assert.Zero(suite.T(), report.Rejected)
+	got, found, err := suite.app.Srv.GetItem(ctx, itemID)
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), found)
+	assert.Equal(suite.T(), item.Name, got.Name)
+	require.Len(suite.T(), got.Related, 1)
+	assert.Equal(suite.T(), item.Related[0].ID, got.Related[0].ID)
+
+	resRec = suite.execRequest(http.MethodGet, "/items/export?mode=unknown", nil)
+	assert.Equal(suite.T(), http.StatusBadRequest, resRec.Code)
+}
+
+func readLines(t require.TestingT, r io.Reader) [][]byte {
+	var lines [][]byte
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		lines = append(lines, append([]byte(nil), scanner.Bytes()...))
+	}
+	require.NoError(t, scanner.Err())
+
+	return lines
+}
+
+func (suite *CrudTestSuite) TestExportItemsCSV() {
+	ctx := context.Background()
+	item := suite.item
+	item.Related = []domain.Nested{{
+		ID:   uuid.Must(uuid.NewV4()),
+		Name: "Nested",
+		Sort: 1,
+		Related: []domain.Atom{
+			{ID: uuid.Must(uuid.NewV4()), Name: `Atom, "quoted"`},
+			{ID: uuid.Must(uuid.NewV4()), Name: "Atom second"},
+		},
+	}}
+	itemID, err := suite.app.Srv.CreateItem(ctx, item)
+	require.NoError(suite.T(), err)
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, itemID)
+	}()
+
+	filter := "related_id=" + item.Related[0].ID.String()
+
+	resRec := suite.execRequest(http.MethodGet, "/items/export.csv?bom=true&columns=item_id,atom_name&"+filter, nil)
+	require.Equal(suite.T(), http.StatusOK, resRec.Code)
+	assert.True(suite.T(), strings.HasPrefix(resRec.Body.String(), "\ufeffitem_id,atom_name\n"))
+
+	rows, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(resRec.Body.String(), "\ufeff"))).ReadAll()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), [][]string{
+		{"item_id", "atom_name"},
+		{itemID.String(), `Atom, "quoted"`},
+		{itemID.String(), "Atom second"},
+	}, rows)
+
+	resRec = suite.execRequest(http.MethodGet, "/items/export.csv?layout=nested&columns=nested_name,atom_name&"+filter, nil)
+	require.Equal(suite.T(), http.StatusOK, resRec.Code)
+	rows, err = csv.NewReader(resRec.Body).ReadAll()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), [][]string{
+		{"nested_name", "atom_name"},
+		{"Nested", `Atom, "quoted"; Atom second`},
+	}, rows)
+
+	resRec = suite.execRequest(http.MethodGet, "/items/export.csv?columns=item_id,unknown", nil)
+	assert.Equal(suite.T(), http.StatusBadRequest, resRec.Code)
+}
@@ -0,0 +1,115 @@
+package test
+
+import (
+	"context"
+	grpcapi "crud/internal/api/grpc"
+	"crud/internal/domain"
+	"errors"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"io"
+	"net"
+)
+
+func (suite *CrudTestSuite) TestGRPCItemLifecycle() {
+	ctx := context.Background()
+	conn := suite.dialGRPC()
+	items := grpcapi.NewItemServiceClient(conn)
+
+	nst := suite.item.Related[0]
+	created, err := items.CreateItem(ctx, &grpcapi.CreateItemRequest{
+		Name: suite.item.Name,
+		Related: []*grpcapi.Nested{{
+			Id:      nst.ID.String(),
+			Name:    nst.Name,
+			Related: []*grpcapi.Atom{{Id: nst.Related[0].ID.String(), Name: nst.Related[0].Name}},
+		}},
+	})
+	require.NoError(suite.T(), err)
+
+	item, err := items.GetItem(ctx, &grpcapi.GetItemRequest{Id: created.GetId()})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.item.Name, item.GetName())
+	assert.Equal(suite.T(), nst.ID.String(), item.GetRelated()[0].GetId())
+
+	_, err = items.DeleteItem(ctx, &grpcapi.DeleteItemRequest{Id: created.GetId()})
+	require.NoError(suite.T(), err)
+
+	_, err = items.GetItem(ctx, &grpcapi.GetItemRequest{Id: created.GetId()})
+	assert.Equal(suite.T(), codes.NotFound, status.Code(err))
+
+	_, err = items.GetItem(ctx, &grpcapi.GetItemRequest{Id: "not-a-uuid"})
+	assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+
+	health, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), grpc_health_v1.HealthCheckResponse_SERVING, health.GetStatus())
+}
+
+// dialGRPC поднимает gRPC сервер приложения на соединении в памяти
+func (suite *CrudTestSuite) dialGRPC() *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	go func() {
+		_ = suite.app.GRPCServer.Serve(lis)
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(suite.T(), err)
+	suite.T().Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+func (suite *CrudTestSuite) TestGRPCExportAndWatch() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	items := grpcapi.NewItemServiceClient(suite.dialGRPC())
+
+	watch, err := items.WatchItems(ctx, &grpcapi.WatchItemsRequest{})
+	require.NoError(suite.T(), err)
+	_, err = watch.Header()
+	require.NoError(suite.T(), err)
+
+	// свой ID вложенного документа, чтобы выгрузка по нему не захватила документы других тестов
+	item := suite.item
+	item.Related = []domain.Nested{{ID: uuid.Must(uuid.NewV4()), Name: "Nested"}}
+	itemID, err := suite.app.Srv.CreateItem(ctx, item)
+	require.NoError(suite.T(), err)
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, itemID)
+	}()
+
+	event, err := watch.Recv()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), grpcapi.ItemEvent_TYPE_CREATED, event.GetType())
+	assert.Equal(suite.T(), itemID.String(), event.GetId())
+	assert.Equal(suite.T(), suite.item.Name, event.GetItem().GetName())
+
+	export, err := items.ExportItems(ctx, &grpcapi.ExportItemsRequest{RelatedId: item.Related[0].ID.String()})
+	require.NoError(suite.T(), err)
+
+	var exported []string
+	for {
+		item, err := export.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(suite.T(), err)
+		exported = append(exported, item.GetId())
+	}
+	assert.Equal(suite.T(), []string{itemID.String()}, exported)
+}
@@ -0,0 +1,60 @@
+package test
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+)
+
+type healthReport struct {
+	Status  string `json:"status"`
+	Version string `json:"version"`
+	Checks  []struct {
+		Name      string  `json:"name"`
+		Status    string  `json:"status"`
+		LatencyMs float64 `json:"latency_ms"`
+		Error     string  `json:"error"`
+	} `json:"checks"`
+}
+
+func (suite *CrudTestSuite) TestHealthChecks() {
+	code, report := suite.getHealth("/health")
+	assert.Equal(suite.T(), http.StatusOK, code)
+	assert.Equal(suite.T(), "pass", report.Status)
+	assert.NotEmpty(suite.T(), report.Version)
+	names := make([]string, 0, len(report.Checks))
+	for _, c := range report.Checks {
+		assert.Equal(suite.T(), "pass", c.Status, "%s: %s", c.Name, c.Error)
+		names = append(names, c.Name)
+	}
+	assert.Equal(suite.T(), []string{"reindexer", "namespace", "indexes", "migrations", "cache"}, names)
+
+	code, report = suite.getHealth("/ready")
+	assert.Equal(suite.T(), http.StatusOK, code)
+	assert.Equal(suite.T(), "startup", report.Checks[0].Name)
+
+	suite.app.HealthChecker.SetReady(false)
+	defer suite.app.HealthChecker.SetReady(true)
+	code, report = suite.getHealth("/ready")
+	assert.Equal(suite.T(), http.StatusServiceUnavailable, code)
+	assert.Equal(suite.T(), "fail", report.Status)
+	require.Len(suite.T(), report.Checks, 1)
+	assert.Equal(suite.T(), "startup is not completed", report.Checks[0].Error)
+
+	response := httptest.NewRecorder()
+	suite.app.Server.Handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/live", nil))
+	assert.Equal(suite.T(), http.StatusOK, response.Code)
+	assert.JSONEq(suite.T(), `{"status":"alive","version":"dev"}`, response.Body.String())
+}
+
+func (suite *CrudTestSuite) getHealth(path string) (int, healthReport) {
+	response := httptest.NewRecorder()
+	suite.app.Server.Handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, path, nil))
+
+	var report healthReport
+	require.NoError(suite.T(), json.Unmarshal(response.Body.Bytes(), &report))
+
+	return response.Code, report
+}
@@ -0,0 +1,161 @@
+package test
+
+import (
+	"context"
+	"crud/internal/domain"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+)
+
+type importReport struct {
+	Accepted int  `json:"accepted"`
+	Rejected int  `json:"rejected"`
+	Skipped  int  `json:"skipped"`
+	Aborted  bool `json:"aborted"`
+	Lines    []struct {
+		Line   int    `json:"line"`
+		ID     string `json:"id"`
+		Status string `json:"status"`
+		Reason string `json:"reason"`
+	} `json:"lines"`
+}
+
+func (suite *CrudTestSuite) TestImportNDJSON() {
+	ctx := context.Background()
+	itemID, _ := uuid.NewV4()
+	nestedID, _ := uuid.NewV4()
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, itemID)
+	}()
+
+	body := fmt.Sprintf(`{"id":"%s","name":"Imported","related":[{"id":"%s","name":"Imported nested","sort":1}]}`, itemID, nestedID) +
+		"\n\n" + `{"name":""}` + "\n" + `not json` + "\n"
+
+	report := suite.importItems("policy=fail", "application/x-ndjson", body)
+	assert.Equal(suite.T(), 1, report.Accepted)
+	assert.Equal(suite.T(), 2, report.Rejected)
+	require.Len(suite.T(), report.Lines, 3)
+	assert.Equal(suite.T(), "created", report.Lines[0].Status)
+	assert.Equal(suite.T(), 3, report.Lines[1].Line)
+	assert.Contains(suite.T(), report.Lines[1].Reason, "name must not be empty")
+	assert.Equal(suite.T(), 4, report.Lines[2].Line)
+
+	item, found, err := suite.app.Srv.GetItem(ctx, itemID)
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), found)
+	assert.Equal(suite.T(), "Imported", item.Name)
+
+	report = suite.importItems("policy=skip", "application/x-ndjson", body)
+	assert.Equal(suite.T(), 0, report.Accepted)
+	assert.Equal(suite.T(), 1, report.Skipped)
+
+	report = suite.importItems("policy=fail", "application/x-ndjson", body)
+	assert.True(suite.T(), report.Aborted)
+	assert.Equal(suite.T(), 0, report.Accepted)
+}
+
+func (suite *CrudTestSuite) TestImportCSV() {
+	ctx := context.Background()
+	itemID, _ := uuid.NewV4()
+	nestedID, _ := uuid.NewV4()
+	atomIDs := []uuid.UUID{uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())}
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, itemID)
+	}()
+
+	body := "item_id,item_name,nested_id,nested_name,nested_sort,atom_id,atom_name\n" +
+		fmt.Sprintf("%s,Imported,%s,Nested,1,%s,\"Atom, first\"\n", itemID, nestedID, atomIDs[0]) +
+		fmt.Sprintf("%s,Imported,%s,Nested,1,%s,Atom second\n", itemID, nestedID, atomIDs[1])
+
+	report := suite.importItems("dry_run=true", "text/csv", body)
+	assert.Equal(suite.T(), 1, report.Accepted)
+	_, found, err := suite.app.Srv.GetItem(ctx, itemID)
+	require.NoError(suite.T(), err)
+	assert.False(suite.T(), found)
+
+	report = suite.importItems("policy=overwrite", "text/csv", body)
+	require.Equal(suite.T(), 1, report.Accepted)
+
+	item, found, err := suite.app.Srv.GetItem(ctx, itemID)
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), found)
+	require.Len(suite.T(), item.Related, 1)
+	require.Len(suite.T(), item.Related[0].Related, 2)
+	assert.Equal(suite.T(), "Atom, first", item.Related[0].Related[0].Name)
+
+	resRec := suite.execRequest(http.MethodPost, "/items/import?format=csv", strings.NewReader("unknown\n"))
+	assert.Equal(suite.T(), http.StatusBadRequest, resRec.Code)
+}
+
+func (suite *CrudTestSuite) importItems(query, contentType, body string) importReport {
+	request, err := http.NewRequest(http.MethodPost, "/items/import?"+query, strings.NewReader(body))
+	require.NoError(suite.T(), err)
+	request.Header.Set("Content-Type", contentType)
+	resRec := httptest.NewRecorder()
+	suite.app.Server.Handler.ServeHTTP(resRec, request)
+	require.Equal(suite.T(), http.StatusOK, resRec.Code, resRec.Body.String())
+
+	var report importReport
+	require.NoError(suite.T(), json.Unmarshal(resRec.Body.Bytes(), &report))
+
+	return report
+}
+
+func (suite *CrudTestSuite) TestImportFailureKeepsReport() {
+	ctx := context.Background()
+	item := suite.item
+	item.ID = uuid.Must(uuid.NewV4())
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, item.ID)
+	}()
+
+	readErr := errors.New("read failed")
+	records := func(yield func(domain.ImportRecord, error) bool) {
+		if !yield(domain.ImportRecord{Line: 1, Item: item}, nil) {
+			return
+		}
+		yield(domain.ImportRecord{}, readErr)
+	}
+
+	report, err := suite.app.Srv.ImportItems(ctx, records, domain.ImportOptions{Policy: domain.ConflictFail, BatchSize: 1})
+	require.ErrorIs(suite.T(), err, readErr)
+	assert.Equal(suite.T(), 1, report.Accepted)
+	require.Len(suite.T(), report.Lines, 1)
+	assert.Equal(suite.T(), domain.ImportCreated, report.Lines[0].Status)
+
+	_, found, err := suite.app.Srv.GetItem(ctx, item.ID)
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), found)
+}
+
+func (suite *CrudTestSuite) TestImportCreatedConcurrently() {
+	ctx := context.Background()
+	item := suite.item
+	item.ID = uuid.Must(uuid.NewV4())
+	require.NoError(suite.T(), suite.client.CreateItem(ctx, item))
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, item.ID)
+	}()
+
+	// документ создан другим запросом между проверкой существования и записью порции
+	now := time.Now()
+	imported := item
+	imported.Name = "Imported"
+	imported.UpdatedAt = &now
+	conflicts, err := suite.client.ImportItems(ctx, []domain.Item{imported}, nil)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []uuid.UUID{item.ID}, conflicts)
+
+	got, found, err := suite.client.GetItem(ctx, item.ID)
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), found)
+	assert.Equal(suite.T(), item.Name, got.Name)
+}
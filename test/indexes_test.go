@@ -0,0 +1,67 @@
+package test
+
+import (
+	"context"
+	"crud/internal/client"
+	"crud/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"slices"
+)
+
+func (suite *CrudTestSuite) TestReconcileIndexes() {
+	ctx := context.Background()
+	cfg := suite.app.Config.DB
+	cfg.Namespace += "_indexes_test"
+	cfg.Indexes = []config.IndexConfig{
+		{Name: "sorting", JSONPaths: []string{"sort"}, IndexType: "tree", FieldType: "int64"},
+		{Name: "name_ci", JSONPaths: []string{"Name"}, IndexType: "tree", FieldType: "string", Collate: "utf8"},
+	}
+	db := client.New(cfg)
+	require.NoError(suite.T(), db.Start(ctx))
+	defer func() {
+		_ = db.DropNamespace(cfg.Namespace)
+		db.Stop(ctx)
+	}()
+
+	// клиент для другого объявления индексов того же пространства имен
+	reconcile := func(cfg config.DbConfig, dryRun bool) (client.IndexReport, error) {
+		c := client.New(cfg)
+		defer c.Close()
+		return c.ReconcileIndexes(ctx, dryRun)
+	}
+
+	report, err := db.ReconcileIndexes(ctx, true)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"sorting", "name_ci"}, report.Added)
+	info, err := db.Inspect(ctx)
+	require.NoError(suite.T(), err)
+	assert.NotContains(suite.T(), info.Indexes, "name_ci", "dry run must not change the namespace")
+
+	report, err = db.ReconcileIndexes(ctx, false)
+	require.NoError(suite.T(), err)
+	assert.Len(suite.T(), report.Added, 2)
+
+	report, err = db.ReconcileIndexes(ctx, false)
+	require.NoError(suite.T(), err)
+	assert.False(suite.T(), report.Changed())
+
+	// объявление изменилось, а name_ci из него убран
+	cfg.Indexes = []config.IndexConfig{{Name: "sorting", JSONPaths: []string{"sort"}, IndexType: "hash", FieldType: "int64"}}
+	report, err = reconcile(cfg, false)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"sorting"}, report.Updated)
+	assert.Equal(suite.T(), []string{"name_ci"}, report.Unknown)
+
+	cfg.DropUnknownIndexes = true
+	report, err = reconcile(cfg, false)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"name_ci"}, report.Dropped)
+	info, err = db.Inspect(ctx)
+	require.NoError(suite.T(), err)
+	assert.False(suite.T(), slices.Contains(info.Indexes, "name_ci"))
+
+	cfg.Indexes = []config.IndexConfig{{Name: "name", IndexType: "hash", FieldType: "string"}}
+	_, err = reconcile(cfg, true)
+	assert.Error(suite.T(), err, "struct indexes cannot be declared")
+}
@@ -0,0 +1,26 @@
+package test
+
+import (
+	"context"
+	"crud/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *CrudTestSuite) TestInspectNamespace() {
+	ctx := context.Background()
+
+	info, err := suite.client.Inspect(ctx)
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), info.Exists)
+	assert.Contains(suite.T(), info.Indexes, "id")
+	assert.Contains(suite.T(), info.Indexes, "seq")
+
+	cfg := suite.app.Config.DB
+	cfg.Namespace += "_missing"
+	missing := client.New(cfg)
+	defer missing.Stop(ctx)
+	info, err = missing.Inspect(ctx)
+	require.NoError(suite.T(), err)
+	assert.False(suite.T(), info.Exists)
+}
@@ -0,0 +1,68 @@
+package test
+
+import (
+	"bufio"
+	"bytes"
+	"crud/internal/logging"
+	"encoding/json"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+)
+
+func (suite *CrudTestSuite) TestRequestID() {
+	request := httptest.NewRequest(http.MethodGet, "/live", nil)
+	request.Header.Set(logging.RequestIDHeader, "test-request-id")
+	response := httptest.NewRecorder()
+	suite.app.Server.Handler.ServeHTTP(response, request)
+	assert.Equal(suite.T(), "test-request-id", response.Header().Get(logging.RequestIDHeader))
+
+	for _, id := range []string{"", "bad request id"} {
+		request = httptest.NewRequest(http.MethodGet, "/live", nil)
+		request.Header.Set(logging.RequestIDHeader, id)
+		response = httptest.NewRecorder()
+		suite.app.Server.Handler.ServeHTTP(response, request)
+
+		generated, err := uuid.FromString(response.Header().Get(logging.RequestIDHeader))
+		assert.NoError(suite.T(), err)
+		assert.False(suite.T(), generated.IsNil())
+	}
+}
+
+func (suite *CrudTestSuite) TestAccessLog() {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	handler := logging.Middleware(logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logging.FromContext(r.Context()).Info("handled")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("abc"))
+	}))
+
+	request := httptest.NewRequest(http.MethodPost, "/items", nil)
+	request.Header.Set(logging.RequestIDHeader, "access-log-id")
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	var lines []map[string]any
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var line map[string]any
+		require.NoError(suite.T(), json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.Len(suite.T(), lines, 2)
+
+	assert.Equal(suite.T(), "handled", lines[0]["msg"])
+	assert.Equal(suite.T(), "access-log-id", lines[0]["request_id"])
+
+	access := lines[1]
+	assert.Equal(suite.T(), "request", access["msg"])
+	assert.Equal(suite.T(), "access-log-id", access["request_id"])
+	assert.Equal(suite.T(), "POST", access["method"])
+	assert.Equal(suite.T(), "/items", access["path"])
+	assert.EqualValues(suite.T(), http.StatusCreated, access["status"])
+	assert.EqualValues(suite.T(), 3, access["bytes"])
+	assert.Contains(suite.T(), access, "latency_ms")
+}
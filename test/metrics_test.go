@@ -0,0 +1,29 @@
+package test
+
+import (
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+)
+
+func (suite *CrudTestSuite) TestMetrics() {
+	id, _ := uuid.NewV4()
+	for _, path := range []string{"/live", "/items/" + id.String()} {
+		response := httptest.NewRecorder()
+		suite.app.Server.Handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	response := httptest.NewRecorder()
+	suite.app.Metrics.Handler().ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(suite.T(), http.StatusOK, response.Code)
+
+	body := response.Body.String()
+	assert.Contains(suite.T(), body, `crud_http_requests_total{code="200",method="GET",operation="GetLive"}`)
+	assert.Contains(suite.T(), body, `crud_http_requests_total{code="404",method="GET",operation="GetItemsId"}`)
+	assert.Contains(suite.T(), body, `crud_http_request_duration_seconds_count{method="GET",operation="GetItemsId"}`)
+	assert.Contains(suite.T(), body, `crud_reindexer_query_duration_seconds_count{operation="client.GetItem"}`)
+	assert.Contains(suite.T(), body, "crud_cache_misses_total")
+	assert.Contains(suite.T(), body, "crud_process_items_total")
+	assert.Contains(suite.T(), body, "go_goroutines")
+}
@@ -0,0 +1,64 @@
+package test
+
+import (
+	"context"
+	"crud/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"time"
+)
+
+func (suite *CrudTestSuite) TestMigrations() {
+	ctx := context.Background()
+	opts := client.MigrateOptions{LockTTL: 3 * time.Second, LockWait: 10 * time.Second}
+
+	states, err := suite.client.MigrationStatus(ctx)
+	require.NoError(suite.T(), err)
+	require.NotEmpty(suite.T(), states)
+	for _, m := range states {
+		assert.True(suite.T(), m.Applied, "migration %d", m.Version)
+	}
+
+	// в новом пространстве имен миграции еще не применены
+	cfg := suite.app.Config.DB
+	cfg.Namespace += "_migrate_test"
+	fresh := client.New(cfg)
+	require.NoError(suite.T(), fresh.Start(ctx))
+	defer func() {
+		for _, suffix := range []string{"", "_sync", "_webhooks", "_deliveries", "_migrations", "_migrations_lock"} {
+			_ = fresh.DropNamespace(cfg.Namespace + suffix)
+		}
+		fresh.Stop(ctx)
+	}()
+
+	pending, err := fresh.MigrationStatus(ctx)
+	require.NoError(suite.T(), err)
+	for _, m := range pending {
+		assert.False(suite.T(), m.Applied, "migration %d", m.Version)
+	}
+
+	// реплики запускаются одновременно, каждую миграцию выполняет только одна
+	var wg sync.WaitGroup
+	applied := make([][]client.MigrationState, 2)
+	errs := make([]error, 2)
+	for i := range applied {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			applied[i], errs[i] = fresh.MigrateUp(ctx, opts)
+		}()
+	}
+	wg.Wait()
+	require.NoError(suite.T(), errs[0])
+	require.NoError(suite.T(), errs[1])
+	assert.Equal(suite.T(), len(pending), len(applied[0])+len(applied[1]))
+
+	done, err := fresh.MigrateUp(ctx, opts)
+	require.NoError(suite.T(), err)
+	assert.Empty(suite.T(), done)
+
+	// заполнение номеров изменений не откатывается
+	_, err = fresh.MigrateDown(ctx, 1, opts)
+	assert.ErrorIs(suite.T(), err, client.ErrMigrationIrreversible)
+}
@@ -0,0 +1,62 @@
+package test
+
+import (
+	"bytes"
+	api "crud/internal/api/http"
+	"crud/internal/logging"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+)
+
+func (suite *CrudTestSuite) TestRecoverPanic() {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+	server := api.Server{Logger: logger}
+	handler := logging.Middleware(logger)(server.Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})))
+
+	request := httptest.NewRequest(http.MethodGet, "/items", nil)
+	request.Header.Set(logging.RequestIDHeader, "panic-request-id")
+	response := httptest.NewRecorder()
+	require.NotPanics(suite.T(), func() {
+		handler.ServeHTTP(response, request)
+	})
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, response.Code)
+	assert.Equal(suite.T(), "application/problem+json", response.Header().Get("Content-Type"))
+	var problem api.Problem
+	require.NoError(suite.T(), json.Unmarshal(response.Body.Bytes(), &problem))
+	require.NotNil(suite.T(), problem.RequestId)
+	assert.Equal(suite.T(), "panic-request-id", *problem.RequestId)
+	assert.Equal(suite.T(), "internal server error", *problem.Detail)
+	assert.NotContains(suite.T(), response.Body.String(), "boom")
+
+	assert.Contains(suite.T(), logs.String(), `"panic":"boom"`)
+	assert.Contains(suite.T(), logs.String(), `"stack":"goroutine`)
+	assert.Contains(suite.T(), logs.String(), `"status":500`)
+}
+
+func (suite *CrudTestSuite) TestRecoverAbort() {
+	server := api.Server{Logger: slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))}
+
+	abort := server.Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+	assert.PanicsWithValue(suite.T(), http.ErrAbortHandler, func() {
+		abort.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/items/export", nil))
+	})
+
+	// ответ уже начат, вместо ошибки соединение обрывается
+	started := server.Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}\n"))
+		panic("boom")
+	}))
+	assert.PanicsWithValue(suite.T(), http.ErrAbortHandler, func() {
+		started.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/items/export", nil))
+	})
+}
@@ -0,0 +1,54 @@
+package test
+
+import (
+	"context"
+	"crud/internal/app"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+func (suite *CrudTestSuite) TestShutdown() {
+	a := app.New()
+	require.NoError(suite.T(), a.Config.Load())
+	a.Bootstrap()
+	require.NoError(suite.T(), a.Srv.Start(context.Background()))
+	a.HealthChecker.SetReady(true)
+
+	start := time.Now()
+	a.Shutdown()
+
+	// остановка не ждет истечения срока, если все остановилось раньше
+	assert.Less(suite.T(), time.Since(start), a.Config.Shutdown.Timeout/2)
+	assert.False(suite.T(), a.HealthChecker.Readiness(context.Background()).Healthy())
+	assert.False(suite.T(), a.DB.IsConnected(context.Background()))
+}
+
+func (suite *CrudTestSuite) TestShutdownClosesEventStreams() {
+	a := app.New()
+	require.NoError(suite.T(), a.Config.Load())
+	a.Bootstrap()
+	require.NoError(suite.T(), a.Srv.Start(context.Background()))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(suite.T(), err)
+	go func() {
+		_ = a.Server.Serve(listener)
+	}()
+
+	response, err := http.Get("http://" + listener.Addr().String() + "/items/events")
+	require.NoError(suite.T(), err)
+	defer response.Body.Close()
+	require.Equal(suite.T(), http.StatusOK, response.StatusCode)
+
+	start := time.Now()
+	a.Shutdown()
+
+	// Shutdown не отменяет запросы, открытая лента завершается по сигналу остановки, а не по сроку
+	assert.Less(suite.T(), time.Since(start), a.Config.Shutdown.Timeout/2)
+	_, err = io.Copy(io.Discard, response.Body)
+	assert.NoError(suite.T(), err)
+}
@@ -0,0 +1,74 @@
+package test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"net/http/httptest"
+)
+
+func (suite *CrudTestSuite) TestTracing() {
+	exporter := tracetest.NewInMemoryExporter()
+	processor := sdktrace.NewSimpleSpanProcessor(exporter)
+	suite.app.Tracing.RegisterSpanProcessor(processor)
+	defer suite.app.Tracing.UnregisterSpanProcessor(processor)
+
+	ctx := context.Background()
+	id, err := suite.app.Srv.CreateItem(ctx, suite.item)
+	require.NoError(suite.T(), err)
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, id)
+	}()
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	request := httptest.NewRequest(http.MethodGet, "/items/"+id.String(), nil)
+	request.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	response := httptest.NewRecorder()
+	suite.app.Server.Handler.ServeHTTP(response, request)
+	require.Equal(suite.T(), http.StatusOK, response.Code)
+
+	spans := make(map[string]tracetest.SpanStub)
+	for _, span := range exporter.GetSpans() {
+		assert.Equal(suite.T(), traceID, span.SpanContext.TraceID().String(), span.Name)
+		spans[span.Name] = span
+	}
+
+	server, ok := spans["GetItemsId"]
+	require.True(suite.T(), ok, "server span")
+	assert.Equal(suite.T(), trace.SpanKindServer, server.SpanKind)
+	assert.Equal(suite.T(), "00f067aa0ba902b7", server.Parent.SpanID().String())
+
+	lookup, ok := spans["service.cacheLookup"]
+	require.True(suite.T(), ok, "cache lookup span")
+	assert.Equal(suite.T(), server.SpanContext.SpanID(), lookup.Parent.SpanID())
+
+	query, ok := spans["client.GetItem"]
+	require.True(suite.T(), ok, "query span")
+	assert.Equal(suite.T(), trace.SpanKindClient, query.SpanKind)
+	attrs := make(map[string]string)
+	for _, attr := range query.Attributes {
+		attrs[string(attr.Key)] = attr.Value.Emit()
+	}
+	assert.Equal(suite.T(), "reindexer", attrs["db.system.name"])
+	assert.Equal(suite.T(), suite.app.Config.DB.Namespace, attrs["db.namespace"])
+	assert.Equal(suite.T(), id.String(), attrs["db.reindexer.id"])
+
+	exporter.Reset()
+	request = httptest.NewRequest(http.MethodGet, "/items?limit=10&offset=0", nil)
+	request.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	response = httptest.NewRecorder()
+	suite.app.Server.Handler.ServeHTTP(response, request)
+	require.Equal(suite.T(), http.StatusOK, response.Code)
+
+	var transforms int
+	for _, span := range exporter.GetSpans() {
+		if span.Name == "service.transform" {
+			transforms++
+		}
+	}
+	assert.Positive(suite.T(), transforms)
+}
@@ -0,0 +1,157 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"crud/internal/service"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+)
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+func (suite *CrudTestSuite) TestWebhookDelivery() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	received := make(chan receivedWebhook, 100)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- receivedWebhook{r.Header, body}
+	}))
+	defer receiver.Close()
+
+	webhookID := suite.createWebhook(receiver.URL, "secret")
+	defer suite.execRequest(http.MethodDelete, "/webhooks/"+webhookID, nil)
+
+	itemID, err := suite.app.Srv.CreateItem(ctx, suite.item)
+	require.NoError(suite.T(), err)
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, itemID)
+	}()
+
+	suite.runDispatcher(ctx, 3)
+
+	for {
+		select {
+		case <-ctx.Done():
+			suite.T().Fatal("webhook was not delivered")
+		case hook := <-received:
+			// в outbox могут оставаться события других тестов
+			if !bytes.Contains(hook.body, []byte(itemID.String())) {
+				continue
+			}
+
+			signature := "sha256=" + service.Sign("secret", hook.header.Get("X-Webhook-Timestamp"), hook.body)
+			assert.Equal(suite.T(), signature, hook.header.Get("X-Webhook-Signature"))
+			assert.Equal(suite.T(), "created", hook.header.Get("X-Webhook-Event"))
+			return
+		}
+	}
+}
+
+func (suite *CrudTestSuite) TestWebhookDeadLetter() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	webhookID := suite.createWebhook(receiver.URL, "secret")
+	defer suite.execRequest(http.MethodDelete, "/webhooks/"+webhookID, nil)
+
+	itemID, err := suite.app.Srv.CreateItem(ctx, suite.item)
+	require.NoError(suite.T(), err)
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, itemID)
+	}()
+
+	suite.runDispatcher(ctx, 1)
+
+	require.Eventually(suite.T(), func() bool {
+		resRec := suite.execRequest(http.MethodGet, "/webhooks/dead-letters?limit=1000&offset=0", nil)
+		return resRec.Code == http.StatusOK && strings.Contains(resRec.Body.String(), itemID.String())
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func (suite *CrudTestSuite) TestWebhookDeliveredOnceByReplicas() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	received := make(chan []byte, 100)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- body
+	}))
+	defer receiver.Close()
+
+	webhookID := suite.createWebhook(receiver.URL, "secret")
+	defer suite.execRequest(http.MethodDelete, "/webhooks/"+webhookID, nil)
+
+	itemID, err := suite.app.Srv.CreateItem(ctx, suite.item)
+	require.NoError(suite.T(), err)
+	defer func() {
+		_ = suite.client.DeleteItem(ctx, itemID)
+	}()
+
+	// две реплики разбирают один outbox и одни доставки
+	suite.runDispatcher(ctx, 3)
+	suite.runDispatcher(ctx, 3)
+
+	var delivered int
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case <-ctx.Done():
+			suite.T().Fatal("webhook was not delivered")
+		case <-timeout:
+			assert.Equal(suite.T(), 1, delivered)
+			return
+		case body := <-received:
+			if bytes.Contains(body, []byte(itemID.String())) {
+				delivered++
+			}
+		}
+	}
+}
+
+func (suite *CrudTestSuite) createWebhook(url, secret string) string {
+	reqBody, err := json.Marshal(map[string]any{"url": url, "secret": secret, "events": []string{"created"}})
+	require.NoError(suite.T(), err)
+
+	resRec := suite.execRequest(http.MethodPost, "/webhooks", bytes.NewReader(reqBody))
+	require.Equal(suite.T(), http.StatusOK, resRec.Code)
+
+	var webhook map[string]any
+	require.NoError(suite.T(), json.Unmarshal(resRec.Body.Bytes(), &webhook))
+
+	return webhook["id"].(string)
+}
+
+// runDispatcher запускает доставку с короткими интервалами до завершения ctx
+func (suite *CrudTestSuite) runDispatcher(ctx context.Context, maxAttempts int) {
+	dispatcher := service.NewDispatcher(suite.client, http.DefaultClient, service.DispatcherOptions{
+		PollInterval: 50 * time.Millisecond,
+		BatchSize:    100,
+		Concurrency:  4,
+		MaxAttempts:  maxAttempts,
+		BackoffBase:  10 * time.Millisecond,
+		BackoffMax:   50 * time.Millisecond,
+	}, slog.Default())
+	go dispatcher.Run(ctx)
+	suite.T().Cleanup(func() {
+		dispatcher.Stop(context.Background())
+	})
+}
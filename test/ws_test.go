@@ -0,0 +1,64 @@
+package test
+
+import (
+	"context"
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http/httptest"
+	"strings"
+	"time"
+)
+
+type wsMessage struct {
+	Type   string `json:"type"`
+	Event  string `json:"event"`
+	ID     string `json:"id"`
+	Detail string `json:"detail"`
+	Item   *struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"item"`
+}
+
+func (suite *CrudTestSuite) TestWebSocketSubscription() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	srv := httptest.NewServer(suite.app.Server.Handler)
+	defer srv.Close()
+
+	itemID, err := suite.app.Srv.CreateItem(ctx, suite.item)
+	require.NoError(suite.T(), err)
+
+	conn, _, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(srv.URL, "http")+"/items/ws", nil)
+	require.NoError(suite.T(), err)
+	defer conn.CloseNow()
+
+	require.NoError(suite.T(), wsjson.Write(ctx, conn, map[string]any{"type": "subscribe", "ids": []string{itemID.String()}}))
+
+	var msg wsMessage
+	require.NoError(suite.T(), wsjson.Read(ctx, conn, &msg))
+	assert.Equal(suite.T(), "item", msg.Type)
+	assert.Equal(suite.T(), "snapshot", msg.Event)
+	assert.Equal(suite.T(), itemID.String(), msg.Item.ID)
+
+	updated := suite.item
+	updated.Name = "Updated name"
+	require.NoError(suite.T(), suite.app.Srv.UpdateItem(ctx, itemID, updated))
+
+	require.NoError(suite.T(), wsjson.Read(ctx, conn, &msg))
+	assert.Equal(suite.T(), "updated", msg.Event)
+	assert.Equal(suite.T(), "Updated name", msg.Item.Name)
+
+	require.NoError(suite.T(), suite.app.Srv.DeleteItem(ctx, itemID))
+
+	msg = wsMessage{}
+	require.NoError(suite.T(), wsjson.Read(ctx, conn, &msg))
+	assert.Equal(suite.T(), "deleted", msg.Type)
+	assert.Equal(suite.T(), itemID.String(), msg.ID)
+
+	require.NoError(suite.T(), wsjson.Write(ctx, conn, map[string]any{"type": "unknown"}))
+	require.NoError(suite.T(), wsjson.Read(ctx, conn, &msg))
+	assert.Equal(suite.T(), "error", msg.Type)
+}